@@ -15,6 +15,8 @@ import (
 	"github.com/iotaledger/hive.go/core/app"
 	"github.com/iotaledger/inx-api-core-v1/pkg/daemon"
 	"github.com/iotaledger/inx-api-core-v1/pkg/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/keymanager"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
 	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
 	"github.com/iotaledger/inx-api-core-v1/pkg/server"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
@@ -39,6 +41,7 @@ type dependencies struct {
 	AppInfo       *app.Info
 	Database      *database.Database
 	Echo          *echo.Echo
+	KeyManager    *keymanager.KeyManager
 	NetworkIDName string               `name:"networkIdName"`
 	Bech32HRP     iotago.NetworkPrefix `name:"bech32HRP"`
 }
@@ -76,6 +79,23 @@ func initConfigPars(c *dig.Container) error {
 
 func provide(c *dig.Container) error {
 
+	if err := c.Provide(func() (*keymanager.KeyManager, error) {
+		keyManager := keymanager.New()
+		for _, keyRange := range ParamsProtocol.MilestonePublicKeys {
+			if err := keyManager.AddKeyRange(keyRange.Key, milestone.Index(keyRange.Start), milestone.Index(keyRange.End), keyRange.Threshold); err != nil {
+				return nil, fmt.Errorf("invalid milestone public key range: %w", err)
+			}
+		}
+
+		if len(ParamsProtocol.MilestonePublicKeys) == 0 {
+			CoreComponent.LogWarn("No milestone public keys configured, milestone signatures can't be verified")
+		}
+
+		return keyManager, nil
+	}); err != nil {
+		return err
+	}
+
 	if err := c.Provide(func() *echo.Echo {
 		e := httpserver.NewEcho(
 			CoreComponent.Logger(),
@@ -124,6 +144,7 @@ func run() error {
 			deps.AppInfo,
 			deps.Database,
 			deps.Database.UTXOManager(),
+			deps.KeyManager,
 			deps.NetworkIDName,
 			deps.Bech32HRP,
 			ParamsRestAPI.Limits.MaxResults,
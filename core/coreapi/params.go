@@ -32,10 +32,29 @@ type ParametersProtocol struct {
 	NetworkID string `default:"chrysalis-mainnet" name:"networkID" usage:"the network ID on which this app operates on"`
 	// Bech32HRP defines the HRP which should be used for Bech32 addresses
 	Bech32HRP string `default:"iota" name:"bech32HRP" usage:"the HRP which should be used for Bech32 addresses"`
+	// MilestonePublicKeys defines the ed25519 public keys of the coordinator that are applicable for milestone index ranges
+	MilestonePublicKeys ConfigPublicKeyRanges `noflag:"true" name:"milestonePublicKeys" usage:"the ed25519 public keys of the coordinator that are applicable for milestone index ranges"`
 }
 
+// ConfigPublicKeyRange defines a milestone public key and the milestone index range it is applicable for.
+type ConfigPublicKeyRange struct {
+	// Key defines the hex encoded ed25519 public key of the coordinator
+	Key string `json:"key" koanf:"key" usage:"the hex encoded ed25519 public key of the coordinator"`
+	// Start defines the first milestone index the key is applicable for
+	Start uint32 `json:"start" koanf:"start" usage:"the first milestone index the key is applicable for"`
+	// End defines the last milestone index the key is applicable for (0 = no end)
+	End uint32 `json:"end" koanf:"end" usage:"the last milestone index the key is applicable for (0 = no end)"`
+	// Threshold defines the minimum amount of valid signatures a milestone in the range needs
+	Threshold int `json:"threshold" koanf:"threshold" usage:"the minimum amount of valid signatures a milestone in the range needs"`
+}
+
+// ConfigPublicKeyRanges defines a list of milestone public key ranges.
+type ConfigPublicKeyRanges []*ConfigPublicKeyRange
+
 var ParamsRestAPI = &ParametersRestAPI{}
-var ParamsProtocol = &ParametersProtocol{}
+var ParamsProtocol = &ParametersProtocol{
+	MilestonePublicKeys: ConfigPublicKeyRanges{},
+}
 
 var params = &app.ComponentParams{
 	Params: map[string]any{
@@ -2,6 +2,7 @@ package database
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/labstack/echo/v4"
 	"go.uber.org/dig"
@@ -53,13 +54,16 @@ func provide(c *dig.Container) error {
 
 	if err := c.Provide(func() (storageOut, error) {
 		CoreComponent.LogInfo("Setting up database ...")
+		if ParamsDatabase.ReadOnly {
+			CoreComponent.LogInfo("Opening databases in read-only mode")
+		}
 
-		tangleDatabase, err := engine.StoreWithDefaultSettings(ParamsDatabase.Tangle.Path, false, hivedb.EngineAuto, engine.AllowedEnginesStorageAuto...)
+		tangleDatabase, err := engine.StoreWithDefaultSettings(ParamsDatabase.Tangle.Path, false, ParamsDatabase.ReadOnly, hivedb.EngineAuto, engine.AllowedEnginesStorageAuto...)
 		if err != nil {
 			return storageOut{}, err
 		}
 
-		utxoDatabase, err := engine.StoreWithDefaultSettings(ParamsDatabase.UTXO.Path, false, hivedb.EngineAuto, engine.AllowedEnginesStorageAuto...)
+		utxoDatabase, err := engine.StoreWithDefaultSettings(ParamsDatabase.UTXO.Path, false, ParamsDatabase.ReadOnly, hivedb.EngineAuto, engine.AllowedEnginesStorageAuto...)
 		if err != nil {
 			return storageOut{}, err
 		}
@@ -80,12 +84,32 @@ func provide(c *dig.Container) error {
 	}
 
 	if err := c.Provide(func(deps storageDeps) (*database.Database, error) {
-		store, err := database.New(deps.TangleDatabase, deps.UTXODatabase, deps.NetworkID, ParamsDatabase.Debug)
+		store, err := database.New(deps.TangleDatabase, deps.UTXODatabase, deps.NetworkID, ParamsDatabase.Debug, ParamsDatabase.ReadOnly)
 		if err != nil {
 			return nil, err
 		}
 
 		store.PrintSnapshotInfo()
+		if ParamsDatabase.PrintLedgerCommitment {
+			if err := store.PrintLedgerCommitment(); err != nil {
+				return nil, fmt.Errorf("computing ledger state commitment failed: %w", err)
+			}
+		}
+
+		if ParamsDatabase.CheckConsistency {
+			CoreComponent.LogInfo("Checking database consistency ...")
+			result, err := store.CheckConsistency(func(mismatch string) {
+				CoreComponent.LogWarn(mismatch)
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			if result.Mismatches > 0 {
+				return nil, fmt.Errorf("database consistency check failed: %d mismatches", result.Mismatches)
+			}
+			CoreComponent.LogInfof("Checking database consistency ... done (%d outputs, %d milestone diffs)", result.Outputs, result.MilestoneDiffs)
+		}
 
 		return store, nil
 	}); err != nil {
@@ -16,6 +16,17 @@ type ParametersDatabase struct {
 		Path string `default:"database/utxo" usage:"the path to the UTXO database folder"`
 	}
 
+	// ReadOnly defines whether to open the databases in read-only mode.
+	ReadOnly bool `default:"false" usage:"open the databases in read-only mode, so several instances can serve the same database directory (the databases must not be written by a node at the same time)"`
+
+	// CheckConsistency defines whether to cross-check the tangle and UTXO databases at startup.
+	CheckConsistency bool `default:"false" usage:"whether to cross-check the tangle and UTXO databases at startup"`
+
+	// PrintLedgerCommitment defines whether to compute and print the ledger state commitment at startup.
+	// Operators compare the commitment across nodes, so it is printed by default. Computing it iterates all unspent outputs,
+	// so it can be disabled to speed up the startup, the commitment is then computed on the first request.
+	PrintLedgerCommitment bool `default:"true" usage:"whether to compute and print the ledger state commitment at startup (otherwise it is computed on the first request)"`
+
 	// Debug defines whether to ignore the check for corrupted databases (should only be used for debug reasons).
 	Debug bool `default:"false" usage:"ignore the check for corrupted databases (should only be used for debug reasons)"`
 }
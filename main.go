@@ -2,8 +2,13 @@ package main
 
 import (
 	"github.com/iotaledger/inx-api-core-v1/core/app"
+	"github.com/iotaledger/inx-api-core-v1/pkg/toolset"
 )
 
 func main() {
+	if toolset.ShouldHandleTools() {
+		toolset.HandleTools()
+	}
+
 	app.App().Run()
 }
@@ -0,0 +1,186 @@
+package commitment
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/serializer"
+	"github.com/iotaledger/inx-api-core-v1/pkg/merkle"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+var (
+	// ErrInvalidBalanceProof is returned if a balance proof does not lead to the ledger state commitment.
+	ErrInvalidBalanceProof = errors.New("invalid balance proof")
+)
+
+// BalanceProof proves the unspent outputs of an address against a ledger state commitment.
+type BalanceProof struct {
+	// The address the outputs belong to.
+	Address iotago.Address
+	// The unspent outputs of the address, sorted by output type and output ID.
+	Outputs []*Output
+	// The audit path from the leaf of the address to the ledger state commitment, starting at the leaf.
+	AuditPath []*merkle.AuditPathNode
+}
+
+// Balance returns the sum of the amounts of the proven outputs.
+func (p *BalanceProof) Balance() uint64 {
+	var balance uint64
+	for _, output := range p.Outputs {
+		balance += output.Amount
+	}
+
+	return balance
+}
+
+// VerifyBalanceProof verifies that the given proof leads to the given ledger state commitment.
+// A valid proof shows that the proven outputs are exactly the unspent outputs of the address in the committed ledger state.
+func VerifyBalanceProof(ledgerCommitment []byte, proof *BalanceProof) error {
+	if proof == nil || proof.Address == nil {
+		return fmt.Errorf("%w: address missing", ErrInvalidBalanceProof)
+	}
+
+	if len(proof.Outputs) == 0 {
+		return fmt.Errorf("%w: no outputs", ErrInvalidBalanceProof)
+	}
+
+	leaf, err := AddressLeaf(proof.Address, proof.Outputs)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidBalanceProof, err)
+	}
+
+	if !merkle.NewHasher().VerifyAuditPath(ledgerCommitment, leaf, proof.AuditPath) {
+		return fmt.Errorf("%w: audit path does not lead to the ledger state commitment", ErrInvalidBalanceProof)
+	}
+
+	return nil
+}
+
+type jsonOutput struct {
+	OutputID   string `json:"outputId"`
+	OutputType byte   `json:"outputType"`
+	Amount     uint64 `json:"amount"`
+}
+
+type jsonAuditPathNode struct {
+	Hash string `json:"hash"`
+	Left bool   `json:"left"`
+}
+
+type jsonBalanceProof struct {
+	Address   string               `json:"address"`
+	Outputs   []*jsonOutput        `json:"outputs"`
+	AuditPath []*jsonAuditPathNode `json:"auditPath"`
+}
+
+// MarshalJSON encodes the proof with hex encoded IDs, hashes and the hex encoded serialized address.
+func (p *BalanceProof) MarshalJSON() ([]byte, error) {
+	addressBytes, err := p.Address.Serialize(serializer.DeSeriModeNoValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	jProof := &jsonBalanceProof{
+		Address:   hex.EncodeToString(addressBytes),
+		Outputs:   make([]*jsonOutput, len(p.Outputs)),
+		AuditPath: make([]*jsonAuditPathNode, len(p.AuditPath)),
+	}
+
+	for i, output := range p.Outputs {
+		jProof.Outputs[i] = &jsonOutput{
+			OutputID:   output.OutputID.ToHex(),
+			OutputType: output.OutputType,
+			Amount:     output.Amount,
+		}
+	}
+
+	for i, node := range p.AuditPath {
+		jProof.AuditPath[i] = &jsonAuditPathNode{
+			Hash: hex.EncodeToString(node.Hash),
+			Left: node.Left,
+		}
+	}
+
+	return json.Marshal(jProof)
+}
+
+// UnmarshalJSON decodes a proof that was encoded with MarshalJSON.
+func (p *BalanceProof) UnmarshalJSON(data []byte) error {
+	jProof := &jsonBalanceProof{}
+	if err := json.Unmarshal(data, jProof); err != nil {
+		return err
+	}
+
+	addressBytes, err := hex.DecodeString(jProof.Address)
+	if err != nil {
+		return fmt.Errorf("can't decode address: %w", err)
+	}
+
+	if len(addressBytes) == 0 {
+		return errors.New("can't decode address: empty address")
+	}
+
+	address, err := iotago.AddressSelector(uint32(addressBytes[0]))
+	if err != nil {
+		return err
+	}
+
+	if _, err := address.Deserialize(addressBytes, serializer.DeSeriModePerformValidation); err != nil {
+		return fmt.Errorf("can't deserialize address: %w", err)
+	}
+
+	outputs := make([]*Output, len(jProof.Outputs))
+	for i, jOutput := range jProof.Outputs {
+		if jOutput == nil {
+			return fmt.Errorf("output %d missing", i)
+		}
+
+		outputIDBytes, err := hex.DecodeString(jOutput.OutputID)
+		if err != nil {
+			return fmt.Errorf("can't decode output ID %d: %w", i, err)
+		}
+
+		if len(outputIDBytes) != iotago.TransactionIDLength+serializer.UInt16ByteSize {
+			return fmt.Errorf("invalid output ID %d length: %d", i, len(outputIDBytes))
+		}
+
+		output := &Output{
+			OutputType: jOutput.OutputType,
+			Amount:     jOutput.Amount,
+		}
+		copy(output.OutputID[:], outputIDBytes)
+		outputs[i] = output
+	}
+
+	auditPath := make([]*merkle.AuditPathNode, len(jProof.AuditPath))
+	for i, jNode := range jProof.AuditPath {
+		if jNode == nil {
+			return fmt.Errorf("audit path node %d missing", i)
+		}
+
+		hash, err := hex.DecodeString(jNode.Hash)
+		if err != nil {
+			return fmt.Errorf("can't decode audit path node %d: %w", i, err)
+		}
+
+		auditPath[i] = &merkle.AuditPathNode{
+			Hash: hash,
+			Left: jNode.Left,
+		}
+	}
+
+	iotagoAddress, ok := address.(iotago.Address)
+	if !ok {
+		return fmt.Errorf("can't decode address: unknown address type %d", addressBytes[0])
+	}
+
+	p.Address = iotagoAddress
+	p.Outputs = outputs
+	p.AuditPath = auditPath
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package commitment_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/commitment"
+	"github.com/iotaledger/inx-api-core-v1/pkg/merkle"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+var testAddressFills = []byte{0x11, 0x22, 0x33}
+
+func testAddressOutputs(fill byte) []*commitment.Output {
+	return []*commitment.Output{
+		{OutputID: testOutputID(fill, 0), OutputType: iotago.OutputSigLockedSingleOutput, Amount: 2_000_000},
+		{OutputID: testOutputID(fill, 1), OutputType: iotago.OutputSigLockedSingleOutput, Amount: 3_000_000},
+		{OutputID: testOutputID(fill, 2), OutputType: iotago.OutputSigLockedDustAllowanceOutput, Amount: 1_000_000},
+	}
+}
+
+// testLedgerTree returns the tree over the leaves of the test addresses, followed by a treasury leaf.
+func testLedgerTree(t *testing.T) *merkle.Tree {
+	t.Helper()
+
+	leaves := make([][]byte, 0, len(testAddressFills)+1)
+	for _, fill := range testAddressFills {
+		leaf, err := commitment.AddressLeaf(testAddress(fill), testAddressOutputs(fill))
+		require.NoError(t, err)
+		leaves = append(leaves, leaf)
+	}
+	leaves = append(leaves, commitment.TreasuryLeaf(iotago.MilestoneID{}, 10_000_000))
+
+	return merkle.NewHasher().NewTree(leaves)
+}
+
+// testBalanceProof returns the proof of the test address at the given leaf index.
+func testBalanceProof(t *testing.T, tree *merkle.Tree, index int) *commitment.BalanceProof {
+	t.Helper()
+
+	auditPath, err := tree.AuditPath(index)
+	require.NoError(t, err)
+
+	return &commitment.BalanceProof{
+		Address:   testAddress(testAddressFills[index]),
+		Outputs:   testAddressOutputs(testAddressFills[index]),
+		AuditPath: auditPath,
+	}
+}
+
+func TestVerifyBalanceProof(t *testing.T) {
+	tree := testLedgerTree(t)
+
+	tests := []struct {
+		name   string
+		root   []byte
+		tamper func(proof *commitment.BalanceProof)
+		valid  bool
+	}{
+		{
+			name:   "valid proof",
+			root:   tree.Root(),
+			tamper: func(_ *commitment.BalanceProof) {},
+			valid:  true,
+		},
+		{
+			name: "amount changed",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.Outputs[0].Amount++
+			},
+			valid: false,
+		},
+		{
+			name: "output added",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.Outputs = append(proof.Outputs, &commitment.Output{
+					OutputID:   testOutputID(0xff, 0),
+					OutputType: iotago.OutputSigLockedSingleOutput,
+					Amount:     1_000_000,
+				})
+			},
+			valid: false,
+		},
+		{
+			name: "output removed",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.Outputs = proof.Outputs[:len(proof.Outputs)-1]
+			},
+			valid: false,
+		},
+		{
+			name: "all outputs removed",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.Outputs = []*commitment.Output{}
+			},
+			valid: false,
+		},
+		{
+			name: "other address",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.Address = testAddress(0x44)
+			},
+			valid: false,
+		},
+		{
+			name: "address missing",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.Address = nil
+			},
+			valid: false,
+		},
+		{
+			name:   "wrong root",
+			root:   merkle.NewHasher().EmptyRoot(),
+			tamper: func(_ *commitment.BalanceProof) {},
+			valid:  false,
+		},
+		{
+			name: "audit path of another leaf index",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				auditPath, err := tree.AuditPath(2)
+				require.NoError(t, err)
+				proof.AuditPath = auditPath
+			},
+			valid: false,
+		},
+		{
+			name: "audit path hash changed",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.AuditPath[0].Hash[0] ^= 0xff
+			},
+			valid: false,
+		},
+		{
+			name: "audit path side changed",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.AuditPath[0].Left = !proof.AuditPath[0].Left
+			},
+			valid: false,
+		},
+		{
+			name: "audit path node missing",
+			root: tree.Root(),
+			tamper: func(proof *commitment.BalanceProof) {
+				proof.AuditPath = proof.AuditPath[:len(proof.AuditPath)-1]
+			},
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof := testBalanceProof(t, tree, 1)
+			tt.tamper(proof)
+
+			err := commitment.VerifyBalanceProof(tt.root, proof)
+			if tt.valid {
+				require.NoError(t, err)
+
+				return
+			}
+			require.ErrorIs(t, err, commitment.ErrInvalidBalanceProof)
+		})
+	}
+}
+
+func TestBalanceProofJSON(t *testing.T) {
+	tree := testLedgerTree(t)
+
+	for index := range testAddressFills {
+		proof := testBalanceProof(t, tree, index)
+
+		proofJSON, err := json.Marshal(proof)
+		require.NoError(t, err)
+
+		decodedProof := &commitment.BalanceProof{}
+		require.NoError(t, json.Unmarshal(proofJSON, decodedProof))
+		require.Equal(t, proof, decodedProof)
+		require.Equal(t, uint64(6_000_000), decodedProof.Balance())
+		require.NoError(t, commitment.VerifyBalanceProof(tree.Root(), decodedProof))
+	}
+}
+
+func TestBalanceProofJSONInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+	}{
+		{
+			name: "address not hex",
+			json: `{"address":"zz","outputs":[],"auditPath":[]}`,
+		},
+		{
+			name: "address empty",
+			json: `{"address":"","outputs":[],"auditPath":[]}`,
+		},
+		{
+			name: "address too short",
+			json: `{"address":"001111","outputs":[],"auditPath":[]}`,
+		},
+		{
+			name: "output ID too short",
+			json: `{"address":"001111111111111111111111111111111111111111111111111111111111111111","outputs":[{"outputId":"aaaa","outputType":0,"amount":1}],"auditPath":[]}`,
+		},
+		{
+			name: "output missing",
+			json: `{"address":"001111111111111111111111111111111111111111111111111111111111111111","outputs":[null],"auditPath":[]}`,
+		},
+		{
+			name: "audit path hash not hex",
+			json: `{"address":"001111111111111111111111111111111111111111111111111111111111111111","outputs":[],"auditPath":[{"hash":"zz","left":true}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Error(t, json.Unmarshal([]byte(tt.json), &commitment.BalanceProof{}))
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package commitment
+
+import (
+	"encoding/binary"
+
+	"github.com/iotaledger/hive.go/serializer"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+// Leaf types of the ledger state commitment.
+const (
+	LeafTypeAddress  byte = 0
+	LeafTypeTreasury byte = 1
+)
+
+// Output is an unspent output that is committed to by the ledger state commitment.
+type Output struct {
+	// The ID of the output.
+	OutputID iotago.UTXOInputID
+	// The type of the output.
+	OutputType iotago.OutputType
+	// The amount of the output.
+	Amount uint64
+}
+
+// AddressLeaf returns the leaf of the ledger state commitment for the given address and its unspent outputs.
+// The outputs must be sorted by output type and output ID, as they are stored in the ledger.
+//
+// Layout: LeafTypeAddress (1 byte) + address (serialized) + output count (4 bytes)
+// + output count * (output ID (34 bytes) + output type (1 byte) + amount (8 bytes)).
+func AddressLeaf(address iotago.Address, outputs []*Output) ([]byte, error) {
+	addressBytes, err := address.Serialize(serializer.DeSeriModeNoValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	leaf := make([]byte, 0, 1+len(addressBytes)+serializer.UInt32ByteSize+len(outputs)*(iotago.TransactionIDLength+serializer.UInt16ByteSize+1+serializer.UInt64ByteSize))
+	leaf = append(leaf, LeafTypeAddress)
+	leaf = append(leaf, addressBytes...)
+	leaf = binary.LittleEndian.AppendUint32(leaf, uint32(len(outputs)))
+	for _, output := range outputs {
+		leaf = append(leaf, output.OutputID[:]...)
+		leaf = append(leaf, output.OutputType)
+		leaf = binary.LittleEndian.AppendUint64(leaf, output.Amount)
+	}
+
+	return leaf, nil
+}
+
+// TreasuryLeaf returns the leaf of the ledger state commitment for the unspent treasury output.
+//
+// Layout: LeafTypeTreasury (1 byte) + milestone ID (32 bytes) + amount (8 bytes).
+func TreasuryLeaf(milestoneID iotago.MilestoneID, amount uint64) []byte {
+	leaf := make([]byte, 0, 1+iotago.MilestoneIDLength+serializer.UInt64ByteSize)
+	leaf = append(leaf, LeafTypeTreasury)
+	leaf = append(leaf, milestoneID[:]...)
+	leaf = binary.LittleEndian.AppendUint64(leaf, amount)
+
+	return leaf
+}
@@ -0,0 +1,82 @@
+package commitment_test
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/commitment"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+func testAddress(fill byte) *iotago.Ed25519Address {
+	address := &iotago.Ed25519Address{}
+	for i := range address {
+		address[i] = fill
+	}
+
+	return address
+}
+
+func testOutputID(fill byte, index uint16) iotago.UTXOInputID {
+	var outputID iotago.UTXOInputID
+	for i := 0; i < iotago.TransactionIDLength; i++ {
+		outputID[i] = fill
+	}
+	binary.LittleEndian.PutUint16(outputID[iotago.TransactionIDLength:], index)
+
+	return outputID
+}
+
+func TestAddressLeaf(t *testing.T) {
+	tests := []struct {
+		name         string
+		address      iotago.Address
+		outputs      []*commitment.Output
+		expectedLeaf string
+	}{
+		{
+			name:    "no outputs",
+			address: testAddress(0x11),
+			outputs: []*commitment.Output{},
+			expectedLeaf: "00" +
+				"00" + "1111111111111111111111111111111111111111111111111111111111111111" +
+				"00000000",
+		},
+		{
+			name:    "two outputs",
+			address: testAddress(0x11),
+			outputs: []*commitment.Output{
+				{OutputID: testOutputID(0xaa, 1), OutputType: iotago.OutputSigLockedSingleOutput, Amount: 1_000_000},
+				{OutputID: testOutputID(0xbb, 258), OutputType: iotago.OutputSigLockedDustAllowanceOutput, Amount: 1},
+			},
+			expectedLeaf: "00" +
+				"00" + "1111111111111111111111111111111111111111111111111111111111111111" +
+				"02000000" +
+				"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa" + "0100" + "00" + "40420f0000000000" +
+				"bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb" + "0201" + "01" + "0100000000000000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leaf, err := commitment.AddressLeaf(tt.address, tt.outputs)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedLeaf, hex.EncodeToString(leaf))
+		})
+	}
+}
+
+func TestTreasuryLeaf(t *testing.T) {
+	var milestoneID iotago.MilestoneID
+	for i := range milestoneID {
+		milestoneID[i] = 0xcc
+	}
+
+	leaf := commitment.TreasuryLeaf(milestoneID, 2_779_530_283_277_761)
+	require.Equal(t, "01"+
+		"cccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc"+
+		"c15d2dd3f7df0900", hex.EncodeToString(leaf))
+}
@@ -0,0 +1,106 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
+)
+
+// ConsistencyMismatchConsumer is a function that consumes the description of a mismatch found during the consistency check.
+type ConsistencyMismatchConsumer func(mismatch string)
+
+// ConsistencyCheckResult is the result of the consistency check of the tangle and UTXO databases.
+type ConsistencyCheckResult struct {
+	// The amount of checked outputs.
+	Outputs int
+	// The amount of checked milestone diffs.
+	MilestoneDiffs int
+	// The amount of mismatches between the tangle and the UTXO database.
+	Mismatches int
+}
+
+// CheckConsistency cross-checks the tangle and the UTXO database. Every mismatch is passed to the consumer.
+//
+// It checks that a milestone diff exists for every milestone between the snapshot index and the ledger index,
+// and that the message of every output created by these milestone diffs exists and was referenced and included in the ledger.
+// Outputs booked at or below the snapshot index or the pruning index and outputs created by solid entry points
+// are skipped, since their messages are not stored anymore.
+func (db *Database) CheckConsistency(consumer ConsistencyMismatchConsumer) (*ConsistencyCheckResult, error) {
+	result := &ConsistencyCheckResult{}
+
+	mismatch := func(format string, args ...interface{}) {
+		result.Mismatches++
+		consumer(fmt.Sprintf(format, args...))
+	}
+
+	checkOutput := func(output *utxo.Output) error {
+		result.Outputs++
+
+		messageID := output.MessageID()
+		if db.SolidEntryPointsContain(messageID) {
+			return nil
+		}
+
+		exists, err := db.messagesStore.Has(messageID)
+		if err != nil {
+			return fmt.Errorf("checking message %s of output %s failed: %w", messageID.ToHex(), output.OutputID().ToHex(), err)
+		}
+
+		if !exists {
+			mismatch("output %s: message %s not found", output.OutputID().ToHex(), messageID.ToHex())
+
+			return nil
+		}
+
+		msgMeta := db.MessageMetadataOrNil(messageID)
+		if msgMeta == nil {
+			mismatch("output %s: metadata of message %s not found", output.OutputID().ToHex(), messageID.ToHex())
+
+			return nil
+		}
+
+		if !msgMeta.IsReferenced() {
+			mismatch("output %s: message %s was not referenced", output.OutputID().ToHex(), messageID.ToHex())
+
+			return nil
+		}
+
+		// outputs of migrated funds are created by the milestone that contains the receipt
+		if !msgMeta.IsMilestone() && !msgMeta.IsIncludedTxInLedger() {
+			mismatch("output %s: transaction of message %s was not included in the ledger", output.OutputID().ToHex(), messageID.ToHex())
+		}
+
+		return nil
+	}
+
+	ledgerIndex := db.utxoManager.ReadLedgerIndex()
+	for msIndex := db.snapshot.SnapshotIndex + 1; msIndex <= ledgerIndex; msIndex++ {
+		result.MilestoneDiffs++
+
+		diff, err := db.utxoManager.MilestoneDiff(msIndex)
+		if err != nil {
+			if errors.Is(err, kvstore.ErrKeyNotFound) {
+				mismatch("milestone %d: milestone diff missing", msIndex)
+
+				continue
+			}
+
+			return nil, fmt.Errorf("loading milestone diff %d failed: %w", msIndex, err)
+		}
+
+		if msIndex <= db.snapshot.PruningIndex {
+			continue
+		}
+
+		for _, output := range diff.Outputs {
+			if err := checkOutput(output); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return result, nil
+}
@@ -52,12 +52,23 @@ type Database struct {
 	// syncstate
 	syncState     *SyncState
 	syncStateOnce sync.Once
+
+	// whether the databases were opened in read-only mode
+	readOnly bool
 }
 
-func New(tangleDatabase, utxoDatabase kvstore.KVStore, networkID uint64, skipHealthCheck bool) (*Database, error) {
+// New creates a new Database on top of the tangle and UTXO databases.
+// If readOnly is set, no writes are issued to the databases, so they can be opened in read-only mode.
+func New(tangleDatabase, utxoDatabase kvstore.KVStore, networkID uint64, skipHealthCheck bool, readOnly bool) (*Database, error) {
+
+	// the health tracker stores the database version if it is missing
+	storeVersion := byte(DBVersion)
+	if readOnly {
+		storeVersion = kvstore.StoreVersionNone
+	}
 
 	checkDatabaseHealth := func(store kvstore.KVStore) error {
-		healthTracker, err := kvstore.NewStoreHealthTracker(store, kvstore.KeyPrefix{StorePrefixHealth}, DBVersion, nil)
+		healthTracker, err := kvstore.NewStoreHealthTracker(store, kvstore.KeyPrefix{StorePrefixHealth}, storeVersion, nil)
 		if err != nil {
 			return err
 		}
@@ -96,6 +107,7 @@ func New(tangleDatabase, utxoDatabase kvstore.KVStore, networkID uint64, skipHea
 		utxoManager:      utxo.New(utxoDatabase),
 		syncState:        nil,
 		syncStateOnce:    sync.Once{},
+		readOnly:         readOnly,
 	}
 
 	if err := db.loadSnapshotInfo(); err != nil {
@@ -111,10 +123,12 @@ func New(tangleDatabase, utxoDatabase kvstore.KVStore, networkID uint64, skipHea
 		return nil, err
 	}
 
-	// delete unused prefixes
-	for _, prefix := range []byte{StorePrefixUnreferencedMessages} {
-		if err := tangleDatabase.DeletePrefix(kvstore.KeyPrefix{prefix}); err != nil {
-			return nil, err
+	if !readOnly {
+		// delete unused prefixes
+		for _, prefix := range []byte{StorePrefixUnreferencedMessages} {
+			if err := tangleDatabase.DeletePrefix(kvstore.KeyPrefix{prefix}); err != nil {
+				return nil, err
+			}
 		}
 	}
 
@@ -125,16 +139,25 @@ func (db *Database) UTXOManager() *utxo.Manager {
 	return db.utxoManager
 }
 
+// ReadOnly returns whether the databases were opened in read-only mode.
+func (db *Database) ReadOnly() bool {
+	return db.readOnly
+}
+
 func (db *Database) CloseDatabases() error {
 	var flushAndCloseError error
-	if err := db.tangleDatabase.Flush(); err != nil {
-		flushAndCloseError = err
+	if !db.readOnly {
+		if err := db.tangleDatabase.Flush(); err != nil {
+			flushAndCloseError = err
+		}
 	}
 	if err := db.tangleDatabase.Close(); err != nil {
 		flushAndCloseError = err
 	}
-	if err := db.utxoDatabase.Flush(); err != nil {
-		flushAndCloseError = err
+	if !db.readOnly {
+		if err := db.utxoDatabase.Flush(); err != nil {
+			flushAndCloseError = err
+		}
 	}
 	if err := db.utxoDatabase.Close(); err != nil {
 		flushAndCloseError = err
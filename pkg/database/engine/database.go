@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
 
 	hivedb "github.com/iotaledger/hive.go/core/database"
@@ -9,6 +10,11 @@ import (
 	"github.com/iotaledger/hive.go/core/kvstore/rocksdb"
 )
 
+var (
+	// ErrReadOnly is returned if a write is attempted on a database that was opened in read-only mode.
+	ErrReadOnly = errors.New("database was opened in read-only mode")
+)
+
 var (
 	AllowedEnginesDefault = []hivedb.Engine{
 		hivedb.EngineAuto,
@@ -26,7 +32,8 @@ var (
 
 // StoreWithDefaultSettings returns a kvstore with default settings.
 // It also checks if the database engine is correct.
-func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, dbEngine hivedb.Engine, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
+// If readOnly is set, the database is opened in read-only mode and all writes to the kvstore fail.
+func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, readOnly bool, dbEngine hivedb.Engine, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
 
 	tmpAllowedEngines := AllowedEnginesDefault
 	if len(allowedEngines) > 0 {
@@ -38,10 +45,16 @@ func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, dbEng
 		return nil, err
 	}
 
+	if readOnly {
+		if err := checkDatabaseNotInUse(path); err != nil {
+			return nil, err
+		}
+	}
+
 	//nolint:exhaustive
 	switch targetEngine {
 	case hivedb.EnginePebble:
-		db, err := NewPebbleDB(path, nil, false)
+		db, err := NewPebbleDB(path, nil, false, readOnly)
 		if err != nil {
 			return nil, err
 		}
@@ -49,6 +62,10 @@ func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, dbEng
 		return pebble.New(db), nil
 
 	case hivedb.EngineRocksDB:
+		if readOnly {
+			return NewReadOnlyRocksDBStore(path)
+		}
+
 		db, err := NewRocksDB(path)
 		if err != nil {
 			return nil, err
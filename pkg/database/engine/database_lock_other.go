@@ -0,0 +1,8 @@
+//go:build !unix
+
+package engine
+
+// checkDatabaseNotInUse is a no-op on platforms without fcntl locks.
+func checkDatabaseNotInUse(_ string) error {
+	return nil
+}
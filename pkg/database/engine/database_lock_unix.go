@@ -0,0 +1,45 @@
+//go:build unix
+
+package engine
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/sys/unix"
+)
+
+// checkDatabaseNotInUse returns an error if another process holds the lock of the database directory.
+// The databases are not locked in read-only mode, so several read-only instances can serve the same database,
+// but they must not serve a database that is still written by a node, since compactions remove the files they read.
+// The lock file is opened read-only and only tested for a conflicting lock (F_GETLK), no lock is acquired.
+// So read-only instances that start at the same time don't refuse each other. If the database was never
+// opened by a writer, there is no lock file and the check is skipped.
+func checkDatabaseNotInUse(path string) error {
+	lockFile, err := os.OpenFile(filepath.Join(path, "LOCK"), os.O_RDONLY, 0)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return fmt.Errorf("opening lock file of database %s failed: %w", path, err)
+	}
+	defer func() { _ = lockFile.Close() }()
+
+	// a shared lock conflicts only with the exclusive lock of a writer.
+	lock := unix.Flock_t{
+		Type:   unix.F_RDLCK,
+		Whence: io.SeekStart,
+	}
+	if err := unix.FcntlFlock(lockFile.Fd(), unix.F_GETLK, &lock); err != nil {
+		return fmt.Errorf("checking lock of database %s failed: %w", path, err)
+	}
+
+	if lock.Type != unix.F_UNLCK {
+		return fmt.Errorf("database %s is in use by another process (pid %d), read-only mode can't be used for a database that is written at the same time", path, lock.Pid)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package engine_test
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	hivedb "github.com/iotaledger/hive.go/core/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/database/engine"
+)
+
+// writerPathEnv is set if the test binary is started as a process that writes the database at the given path.
+const writerPathEnv = "ENGINE_TEST_WRITER_PATH"
+
+var (
+	testKey   = []byte("key")
+	testValue = []byte("value")
+)
+
+// TestWriterProcess is not a real test, it holds the database open for writing until stdin is closed.
+func TestWriterProcess(t *testing.T) {
+	path := os.Getenv(writerPathEnv)
+	if path == "" {
+		t.Skip("only used as writer process")
+	}
+
+	store, err := engine.StoreWithDefaultSettings(path, false, false, hivedb.EnginePebble)
+	require.NoError(t, err)
+
+	fmt.Println("ready")
+	_, _ = io.Copy(io.Discard, os.Stdin)
+
+	require.NoError(t, store.Close())
+}
+
+func createTestDatabase(t *testing.T) string {
+	t.Helper()
+
+	path := t.TempDir()
+	store, err := engine.StoreWithDefaultSettings(path, true, false, hivedb.EnginePebble)
+	require.NoError(t, err)
+	require.NoError(t, store.Set(testKey, testValue))
+	// the WAL is disabled, so the memtable must be flushed before the database is closed.
+	require.NoError(t, store.Flush())
+	require.NoError(t, store.Close())
+
+	return path
+}
+
+func TestStoreReadOnly(t *testing.T) {
+	tests := []struct {
+		name      string
+		prepare   func(t *testing.T, path string)
+		openCount int
+	}{
+		{
+			name:      "single instance",
+			prepare:   func(_ *testing.T, _ string) {},
+			openCount: 1,
+		},
+		{
+			name:      "two instances at once",
+			prepare:   func(_ *testing.T, _ string) {},
+			openCount: 2,
+		},
+		{
+			name: "missing lock file",
+			prepare: func(t *testing.T, path string) {
+				require.NoError(t, os.Remove(filepath.Join(path, "LOCK")))
+			},
+			openCount: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := createTestDatabase(t)
+			tt.prepare(t, path)
+
+			// the instances are opened at the same time and must not refuse each other.
+			var wg sync.WaitGroup
+			errs := make([]error, tt.openCount)
+			for i := 0; i < tt.openCount; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					store, err := engine.StoreWithDefaultSettings(path, false, true, hivedb.EnginePebble)
+					if err != nil {
+						errs[i] = err
+
+						return
+					}
+					defer func() { _ = store.Close() }()
+
+					value, err := store.Get(testKey)
+					if err != nil {
+						errs[i] = err
+
+						return
+					}
+					if string(value) != string(testValue) {
+						errs[i] = fmt.Errorf("unexpected value: %s", value)
+
+						return
+					}
+					if err := store.Set(testKey, []byte("other")); err == nil {
+						errs[i] = fmt.Errorf("write to read-only database succeeded")
+					}
+				}(i)
+			}
+			wg.Wait()
+
+			for _, err := range errs {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStoreReadOnlyWhileWritten(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the lock of the database is only checked on unix")
+	}
+
+	path := createTestDatabase(t)
+
+	// fcntl locks of the own process don't conflict, so the database is written by another process.
+	writer := exec.Command(os.Args[0], "-test.run=^TestWriterProcess$")
+	writer.Env = append(os.Environ(), writerPathEnv+"="+path)
+	writerStdin, err := writer.StdinPipe()
+	require.NoError(t, err)
+	writerStdout, err := writer.StdoutPipe()
+	require.NoError(t, err)
+	require.NoError(t, writer.Start())
+
+	line, err := bufio.NewReader(writerStdout).ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "ready\n", line)
+
+	_, err = engine.StoreWithDefaultSettings(path, false, true, hivedb.EnginePebble)
+	require.ErrorContains(t, err, "is in use by another process")
+
+	require.NoError(t, writerStdin.Close())
+	require.NoError(t, writer.Wait())
+
+	// the lock is released when the writer exits.
+	store, err := engine.StoreWithDefaultSettings(path, false, true, hivedb.EnginePebble)
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+}
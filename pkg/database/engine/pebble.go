@@ -1,16 +1,36 @@
 package engine
 
 import (
+	"io"
 	"time"
 
 	pebbleDB "github.com/cockroachdb/pebble"
 	"github.com/cockroachdb/pebble/bloom"
+	"github.com/cockroachdb/pebble/vfs"
 
 	"github.com/iotaledger/hive.go/core/kvstore/pebble"
 )
 
+// noopCloser is returned by readOnlyFS instead of a file lock.
+type noopCloser struct{}
+
+func (noopCloser) Close() error { return nil }
+
+// readOnlyFS is a filesystem that doesn't lock the database directory.
+// Pebble creates and exclusively locks the LOCK file even in read-only mode,
+// which fails on read-only filesystems and prevents several processes from opening the same database.
+// StoreWithDefaultSettings checks that no writing process holds the lock before the database is opened.
+type readOnlyFS struct {
+	vfs.FS
+}
+
+func (readOnlyFS) Lock(_ string) (io.Closer, error) {
+	return noopCloser{}, nil
+}
+
 // NewPebbleDB creates a new pebble DB instance.
-func NewPebbleDB(directory string, reportCompactionRunning func(running bool), enableFilter bool) (*pebbleDB.DB, error) {
+// If readOnly is set, the database is opened without locking the directory and all writes to it fail.
+func NewPebbleDB(directory string, reportCompactionRunning func(running bool), enableFilter bool, readOnly bool) (*pebbleDB.DB, error) {
 	cache := pebbleDB.NewCache(128 << 20) // 128 MB
 	defer cache.Unref()
 
@@ -238,5 +258,15 @@ func NewPebbleDB(directory string, reportCompactionRunning func(running bool), e
 	// The default value is 1.
 	opts.MaxConcurrentCompactions = func() int { return 1 }
 
+	// ReadOnly indicates that the DB should be opened in read-only mode. Writes
+	// to the DB will return an error, background compactions are disabled, and
+	// the WAL is replayed into memory instead of being flushed to disk.
+	//
+	// The default value is false.
+	if readOnly {
+		opts.ReadOnly = true
+		opts.FS = readOnlyFS{FS: vfs.Default}
+	}
+
 	return pebble.CreateDB(directory, opts)
 }
@@ -0,0 +1,244 @@
+//go:build rocksdb
+
+package engine
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/iotaledger/grocksdb"
+	"github.com/iotaledger/hive.go/core/byteutils"
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/core/kvstore/utils"
+)
+
+// readOnlyRocksDB holds a grocksdb.DB instance that was opened in read-only mode.
+type readOnlyRocksDB struct {
+	db *grocksdb.DB
+	ro *grocksdb.ReadOptions
+}
+
+// readOnlyRocksDBStore is a kvstore on top of a RocksDB instance that was opened in read-only mode.
+// RocksDB doesn't lock the database directory in read-only mode, so several processes can open the same database.
+// All writes return ErrReadOnly.
+// The read path is copied from the rocksDBStore of hive.go (kvstore/rocksdb), which can't wrap a read-only instance.
+type readOnlyRocksDBStore struct {
+	instance *readOnlyRocksDB
+	dbPrefix []byte
+	closed   *atomic.Bool
+}
+
+// NewReadOnlyRocksDBStore opens the RocksDB database at the given path in read-only mode.
+func NewReadOnlyRocksDBStore(path string) (kvstore.KVStore, error) {
+	opts := grocksdb.NewDefaultOptions()
+	opts.SetCompression(grocksdb.NoCompression)
+
+	// the WAL is replayed into memory, since it can't be flushed to disk in read-only mode
+	db, err := grocksdb.OpenDbForReadOnly(opts, path, false)
+	if err != nil {
+		return nil, err
+	}
+
+	ro := grocksdb.NewDefaultReadOptions()
+	ro.SetFillCache(false)
+
+	return &readOnlyRocksDBStore{
+		instance: &readOnlyRocksDB{
+			db: db,
+			ro: ro,
+		},
+		closed: &atomic.Bool{},
+	}, nil
+}
+
+func (s *readOnlyRocksDBStore) WithRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	if s.closed.Load() {
+		return nil, kvstore.ErrStoreClosed
+	}
+
+	return &readOnlyRocksDBStore{
+		instance: s.instance,
+		closed:   s.closed,
+		dbPrefix: realm,
+	}, nil
+}
+
+func (s *readOnlyRocksDBStore) WithExtendedRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	return s.WithRealm(byteutils.ConcatBytes(s.Realm(), realm))
+}
+
+func (s *readOnlyRocksDBStore) Realm() []byte {
+	return s.dbPrefix
+}
+
+// builds a key usable using the realm and the given prefix.
+func (s *readOnlyRocksDBStore) buildKeyPrefix(prefix kvstore.KeyPrefix) kvstore.KeyPrefix {
+	return byteutils.ConcatBytes(s.dbPrefix, prefix)
+}
+
+// getIterFuncs returns the function pointers for the iteration based on the given settings.
+func (s *readOnlyRocksDBStore) getIterFuncs(it *grocksdb.Iterator, keyPrefix []byte, iterDirection ...kvstore.IterDirection) (start func(), valid func() bool, move func(), err error) {
+
+	startFunc := it.SeekToFirst
+	validFunc := it.Valid
+	moveFunc := it.Next
+
+	if len(keyPrefix) > 0 {
+		startFunc = func() {
+			it.Seek(keyPrefix)
+		}
+		validFunc = func() bool {
+			return it.ValidForPrefix(keyPrefix)
+		}
+	}
+
+	if kvstore.GetIterDirection(iterDirection...) == kvstore.IterDirectionBackward {
+		startFunc = it.SeekToLast
+		moveFunc = it.Prev
+
+		if len(keyPrefix) > 0 {
+			// we need to search the first item after the prefix
+			prefixUpperBound := utils.KeyPrefixUpperBound(keyPrefix)
+			if prefixUpperBound == nil {
+				return nil, nil, nil, errors.New("no upper bound for prefix")
+			}
+			startFunc = func() {
+				it.SeekForPrev(prefixUpperBound)
+
+				// if the upper bound exists (not part of the prefix set), we need to use the next entry
+				if !validFunc() {
+					moveFunc()
+				}
+			}
+		}
+	}
+
+	return startFunc, validFunc, moveFunc, nil
+}
+
+// Iterate iterates over all keys and values with the provided prefix. You can pass kvstore.EmptyPrefix to iterate over all keys and values.
+// Optionally the direction for the iteration can be passed (default: IterDirectionForward).
+func (s *readOnlyRocksDBStore) Iterate(prefix kvstore.KeyPrefix, consumerFunc kvstore.IteratorKeyValueConsumerFunc, iterDirection ...kvstore.IterDirection) error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	it := s.instance.db.NewIterator(s.instance.ro)
+	defer it.Close()
+
+	startFunc, validFunc, moveFunc, err := s.getIterFuncs(it, s.buildKeyPrefix(prefix), iterDirection...)
+	if err != nil {
+		return err
+	}
+
+	for startFunc(); validFunc(); moveFunc() {
+		key := it.Key()
+		k := utils.CopyBytes(key.Data(), key.Size())[len(s.dbPrefix):]
+		key.Free()
+
+		value := it.Value()
+		v := utils.CopyBytes(value.Data(), value.Size())
+		value.Free()
+
+		if !consumerFunc(k, v) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// IterateKeys iterates over all keys with the provided prefix. You can pass kvstore.EmptyPrefix to iterate over all keys.
+// Optionally the direction for the iteration can be passed (default: IterDirectionForward).
+func (s *readOnlyRocksDBStore) IterateKeys(prefix kvstore.KeyPrefix, consumerFunc kvstore.IteratorKeyConsumerFunc, iterDirection ...kvstore.IterDirection) error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	it := s.instance.db.NewIterator(s.instance.ro)
+	defer it.Close()
+
+	startFunc, validFunc, moveFunc, err := s.getIterFuncs(it, s.buildKeyPrefix(prefix), iterDirection...)
+	if err != nil {
+		return err
+	}
+
+	for startFunc(); validFunc(); moveFunc() {
+		key := it.Key()
+		k := utils.CopyBytes(key.Data(), key.Size())[len(s.dbPrefix):]
+		key.Free()
+
+		if !consumerFunc(k) {
+			break
+		}
+	}
+
+	return nil
+}
+
+func (s *readOnlyRocksDBStore) Clear() error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyRocksDBStore) Get(key kvstore.Key) (kvstore.Value, error) {
+	if s.closed.Load() {
+		return nil, kvstore.ErrStoreClosed
+	}
+
+	v, err := s.instance.db.GetBytes(s.instance.ro, byteutils.ConcatBytes(s.dbPrefix, key))
+	if err != nil {
+		return nil, err
+	}
+	if v == nil {
+		return nil, kvstore.ErrKeyNotFound
+	}
+
+	return v, nil
+}
+
+func (s *readOnlyRocksDBStore) Set(_ kvstore.Key, _ kvstore.Value) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyRocksDBStore) Has(key kvstore.Key) (bool, error) {
+	if s.closed.Load() {
+		return false, kvstore.ErrStoreClosed
+	}
+
+	v, err := s.instance.db.Get(s.instance.ro, byteutils.ConcatBytes(s.dbPrefix, key))
+	if err != nil {
+		return false, err
+	}
+	defer v.Free()
+
+	return v.Exists(), nil
+}
+
+func (s *readOnlyRocksDBStore) Delete(_ kvstore.Key) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyRocksDBStore) DeletePrefix(_ kvstore.KeyPrefix) error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyRocksDBStore) Flush() error {
+	return ErrReadOnly
+}
+
+func (s *readOnlyRocksDBStore) Close() error {
+	if s.closed.Swap(true) {
+		// was already closed
+		return nil
+	}
+
+	s.instance.db.Close()
+
+	return nil
+}
+
+func (s *readOnlyRocksDBStore) Batched() (kvstore.BatchedMutations, error) {
+	return nil, ErrReadOnly
+}
+
+var _ kvstore.KVStore = &readOnlyRocksDBStore{}
@@ -0,0 +1,19 @@
+//go:build !rocksdb
+
+package engine
+
+import (
+	"errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+)
+
+var (
+	// ErrRocksDBNotSupported is returned if a RocksDB database is opened in a binary that was compiled without RocksDB support.
+	ErrRocksDBNotSupported = errors.New("for RocksDB support please compile with '-tags rocksdb'")
+)
+
+// NewReadOnlyRocksDBStore opens the RocksDB database at the given path in read-only mode.
+func NewReadOnlyRocksDBStore(_ string) (kvstore.KVStore, error) {
+	return nil, ErrRocksDBNotSupported
+}
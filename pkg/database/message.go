@@ -42,6 +42,41 @@ func (msg *Message) Message() *iotago.Message {
 	return msg.message
 }
 
+// Transaction returns the transaction payload of the message or nil if the message does not contain a transaction.
+func (msg *Message) Transaction() *iotago.Transaction {
+	transaction, ok := msg.Message().Payload.(*iotago.Transaction)
+	if !ok {
+		return nil
+	}
+
+	return transaction
+}
+
+// TransactionEssence returns the essence of the transaction payload of the message or nil if the message does not contain a transaction.
+func (msg *Message) TransactionEssence() *iotago.TransactionEssence {
+	transaction := msg.Transaction()
+	if transaction == nil {
+		return nil
+	}
+
+	essence, ok := transaction.Essence.(*iotago.TransactionEssence)
+	if !ok {
+		return nil
+	}
+
+	return essence
+}
+
+// Milestone returns the milestone payload of the message or nil if the message does not contain a milestone.
+func (msg *Message) Milestone() *iotago.Milestone {
+	ms, ok := msg.Message().Payload.(*iotago.Milestone)
+	if !ok {
+		return nil
+	}
+
+	return ms
+}
+
 func messageFactory(key []byte, data []byte) *Message {
 	return &Message{
 		messageID: hornet.MessageIDFromSlice(key[:iotago.MessageIDLength]),
@@ -0,0 +1,126 @@
+package database
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/merkle"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+)
+
+// MilestoneInclusionVerification is the result of the verification of the inclusion merkle proof of a milestone.
+type MilestoneInclusionVerification struct {
+	// The index of the milestone.
+	Index milestone.Index
+	// The IDs of the messages with included transactions in white-flag order.
+	IncludedMessageIDs hornet.MessageIDs
+	// The inclusion merkle proof contained in the milestone payload.
+	InclusionMerkleProof []byte
+	// The recomputed merkle tree hash of the included messages.
+	ComputedMerkleProof []byte
+}
+
+// Valid returns whether the recomputed merkle tree hash matches the inclusion merkle proof of the milestone.
+func (v *MilestoneInclusionVerification) Valid() bool {
+	return bytes.Equal(v.InclusionMerkleProof, v.ComputedMerkleProof)
+}
+
+// MilestoneIncludedMessageIDs returns the IDs of the messages with included transactions of the given milestone in white-flag order.
+func (db *Database) MilestoneIncludedMessageIDs(milestoneIndex milestone.Index) (hornet.MessageIDs, error) {
+	includedMessageIDs := make(hornet.MessageIDs, 0)
+	if err := db.ForEachMilestoneReferencedMessage(milestoneIndex, func(msgMeta *MessageMetadata) bool {
+		if msgMeta.IsIncludedTxInLedger() {
+			includedMessageIDs = append(includedMessageIDs, msgMeta.MessageID())
+		}
+
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	return includedMessageIDs, nil
+}
+
+// VerifyMilestoneInclusionMerkleProof recomputes the white-flag merkle tree hash of the messages with
+// included transactions of the given milestone and compares it to the inclusion merkle proof of the milestone payload.
+func (db *Database) VerifyMilestoneInclusionMerkleProof(milestoneIndex milestone.Index) (*MilestoneInclusionVerification, error) {
+	ms := db.MilestonePayloadOrNil(milestoneIndex)
+	if ms == nil {
+		return nil, fmt.Errorf("%w: %d", ErrMilestoneNotFound, milestoneIndex)
+	}
+
+	includedMessageIDs, err := db.MilestoneIncludedMessageIDs(milestoneIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MilestoneInclusionVerification{
+		Index:                milestoneIndex,
+		IncludedMessageIDs:   includedMessageIDs,
+		InclusionMerkleProof: ms.InclusionMerkleProof[:],
+		ComputedMerkleProof:  merkle.NewHasher().TreeHash(includedMessageIDs),
+	}, nil
+}
+
+// MessageInclusionProof proves the inclusion of a message in the ledger by a milestone.
+type MessageInclusionProof struct {
+	// The index of the milestone that included the message.
+	MilestoneIndex milestone.Index
+	// The message that contains the milestone payload.
+	MilestoneMessage *Message
+	// The audit path from the message ID to the inclusion merkle proof of the milestone.
+	AuditPath []*merkle.AuditPathNode
+}
+
+// MessageInclusionProof returns the audit path of the given message to the inclusion merkle proof of the milestone that included it.
+func (db *Database) MessageInclusionProof(messageID hornet.MessageID) (*MessageInclusionProof, error) {
+	msgMeta := db.MessageMetadataOrNil(messageID)
+	if msgMeta == nil {
+		return nil, fmt.Errorf("%w: %s", ErrMessageNotFound, messageID.ToHex())
+	}
+
+	referenced, msIndex := msgMeta.ReferencedWithIndex()
+	if !referenced || !msgMeta.IsIncludedTxInLedger() {
+		return nil, fmt.Errorf("%w: %s", ErrMessageNotIncluded, messageID.ToHex())
+	}
+
+	ms := db.MilestoneOrNil(msIndex)
+	if ms == nil {
+		return nil, fmt.Errorf("%w: %d", ErrMilestoneNotFound, msIndex)
+	}
+
+	msMsg := db.MessageOrNil(ms.MessageID)
+	if msMsg == nil {
+		return nil, fmt.Errorf("%w: %s", ErrMessageNotFound, ms.MessageID.ToHex())
+	}
+
+	includedMessageIDs, err := db.MilestoneIncludedMessageIDs(msIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	leafIndex := -1
+	data := make([][]byte, len(includedMessageIDs))
+	for i, includedMessageID := range includedMessageIDs {
+		data[i] = includedMessageID
+		if bytes.Equal(includedMessageID, messageID) {
+			leafIndex = i
+		}
+	}
+
+	if leafIndex == -1 {
+		return nil, fmt.Errorf("%w: %s not found in the included messages of milestone %d", ErrMessageNotIncluded, messageID.ToHex(), msIndex)
+	}
+
+	auditPath, err := merkle.NewHasher().AuditPath(data, leafIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MessageInclusionProof{
+		MilestoneIndex:   msIndex,
+		MilestoneMessage: msMsg,
+		AuditPath:        auditPath,
+	}, nil
+}
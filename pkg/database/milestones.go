@@ -58,6 +58,21 @@ func (db *Database) MilestoneOrNil(milestoneIndex milestone.Index) *Milestone {
 	return milestoneFactory(key, data)
 }
 
+// MilestonePayloadOrNil returns the milestone payload of the milestone with the given index.
+func (db *Database) MilestonePayloadOrNil(milestoneIndex milestone.Index) *iotago.Milestone {
+	ms := db.MilestoneOrNil(milestoneIndex)
+	if ms == nil {
+		return nil
+	}
+
+	msg := db.MessageOrNil(ms.MessageID)
+	if msg == nil {
+		return nil
+	}
+
+	return msg.Milestone()
+}
+
 // MilestoneTimestampUnixByIndex returns the unix timestamp of a milestone.
 func (db *Database) MilestoneTimestampUnixByIndex(milestoneIndex milestone.Index) (int64, error) {
 	ms := db.MilestoneOrNil(milestoneIndex)
@@ -0,0 +1,153 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+// InvalidTransactionSignatures describes a stored transaction whose signatures don't verify against its input addresses.
+type InvalidTransactionSignatures struct {
+	// The index of the milestone that included the transaction.
+	MilestoneIndex milestone.Index
+	// The ID of the message that contains the transaction.
+	MessageID hornet.MessageID
+	// The ID of the transaction.
+	TransactionID *iotago.TransactionID
+	// The reasons why the signatures don't verify.
+	Errors []string
+}
+
+// TransactionSignatureAuditResult is the result of the re-verification of the signatures of the stored transactions.
+type TransactionSignatureAuditResult struct {
+	// The first milestone index of the audited range.
+	StartIndex milestone.Index
+	// The last milestone index of the audited range.
+	EndIndex milestone.Index
+	// The amount of audited transactions.
+	Transactions int
+	// The transactions whose signatures don't verify.
+	InvalidTransactions []*InvalidTransactionSignatures
+}
+
+// transactionSignatureErrors re-verifies the signature unlock blocks of the given transaction
+// against the addresses of the referenced inputs and returns the reasons why they don't verify.
+func (db *Database) transactionSignatureErrors(tx *iotago.Transaction) ([]string, error) {
+	if err := tx.SyntacticallyValidate(); err != nil {
+		return []string{err.Error()}, nil
+	}
+
+	//nolint:forcetypeassert // the essence type is checked by the syntactic validation
+	essence := tx.Essence.(*iotago.TransactionEssence)
+
+	utxos := make(iotago.InputToOutputMapping)
+	for _, input := range essence.Inputs {
+		//nolint:forcetypeassert // the input type is checked by the syntactic validation
+		utxoInputID := input.(*iotago.UTXOInput).ID()
+
+		output, err := db.utxoManager.ReadOutputByOutputID(&utxoInputID)
+		if err != nil {
+			if errors.Is(err, kvstore.ErrKeyNotFound) {
+				return []string{fmt.Sprintf("input %s not found", utxoInputID.ToHex())}, nil
+			}
+
+			return nil, err
+		}
+
+		transactionOutput, err := output.TransactionOutput()
+		if err != nil {
+			return nil, err
+		}
+		utxos[utxoInputID] = transactionOutput
+	}
+
+	essenceBytes, err := essence.SigningMessage()
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+
+	_, sigValidFuncs, err := tx.SemanticallyValidateInputs(utxos, essence, essenceBytes)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+
+	signatureErrors := make([]string, 0)
+	for _, sigValidFunc := range sigValidFuncs {
+		if err := sigValidFunc(); err != nil {
+			signatureErrors = append(signatureErrors, err.Error())
+		}
+	}
+
+	return signatureErrors, nil
+}
+
+// AuditTransactionSignatures re-verifies the Ed25519 signature unlock blocks of all transactions that were included
+// in the ledger by the milestones in the given range. Conflicting transactions are not audited,
+// since they were never applied to the ledger and may contain invalid signatures by design.
+func (db *Database) AuditTransactionSignatures(startIndex milestone.Index, endIndex milestone.Index) (*TransactionSignatureAuditResult, error) {
+	result := &TransactionSignatureAuditResult{
+		StartIndex:          startIndex,
+		EndIndex:            endIndex,
+		InvalidTransactions: make([]*InvalidTransactionSignatures, 0),
+	}
+
+	for msIndex := startIndex; msIndex <= endIndex; msIndex++ {
+		var innerErr error
+		if err := db.ForEachMilestoneReferencedMessage(msIndex, func(msgMeta *MessageMetadata) bool {
+			if !msgMeta.IsIncludedTxInLedger() {
+				return true
+			}
+
+			msg := db.MessageOrNil(msgMeta.MessageID())
+			if msg == nil {
+				innerErr = fmt.Errorf("%w: %s", ErrMessageNotFound, msgMeta.MessageID().ToHex())
+
+				return false
+			}
+
+			tx := msg.Transaction()
+			if tx == nil {
+				return true
+			}
+			result.Transactions++
+
+			signatureErrors, err := db.transactionSignatureErrors(tx)
+			if err != nil {
+				innerErr = err
+
+				return false
+			}
+
+			if len(signatureErrors) > 0 {
+				transactionID, err := tx.ID()
+				if err != nil {
+					innerErr = err
+
+					return false
+				}
+
+				result.InvalidTransactions = append(result.InvalidTransactions, &InvalidTransactionSignatures{
+					MilestoneIndex: msIndex,
+					MessageID:      msgMeta.MessageID(),
+					TransactionID:  transactionID,
+					Errors:         signatureErrors,
+				})
+			}
+
+			return true
+		}); err != nil {
+			return nil, err
+		}
+
+		if innerErr != nil {
+			return nil, innerErr
+		}
+	}
+
+	return result, nil
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -48,6 +49,11 @@ func (db *Database) loadSnapshotInfo() error {
 	return nil
 }
 
+// SnapshotInfo returns the snapshot info of the database.
+func (db *Database) SnapshotInfo() *SnapshotInfo {
+	return db.snapshot
+}
+
 func (db *Database) PrintSnapshotInfo() {
 	if db.snapshot != nil {
 		println(fmt.Sprintf(`SnapshotInfo:
@@ -59,6 +65,22 @@ func (db *Database) PrintSnapshotInfo() {
 	}
 }
 
+// PrintLedgerCommitment computes the ledger state commitment and prints it.
+func (db *Database) PrintLedgerCommitment() error {
+	ledgerCommitment, err := db.utxoManager.LedgerCommitment()
+	if err != nil {
+		return err
+	}
+
+	println(fmt.Sprintf(`LedgerCommitment:
+    LedgerIndex: %d
+    Commitment: %s
+    Addresses: %d
+    UnspentOutputs: %d`, ledgerCommitment.LedgerIndex, hex.EncodeToString(ledgerCommitment.Root()), ledgerCommitment.Addresses(), ledgerCommitment.UnspentOutputs))
+
+	return nil
+}
+
 func snapshotInfoFromBytes(bytes []byte) (*SnapshotInfo, error) {
 
 	if len(bytes) != 29 {
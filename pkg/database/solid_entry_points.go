@@ -26,6 +26,13 @@ func (s *SolidEntryPoints) Add(messageID hornet.MessageID, milestoneIndex milest
 	}
 }
 
+// Contains returns whether the given message is a solid entry point.
+func (s *SolidEntryPoints) Contains(messageID hornet.MessageID) bool {
+	_, exists := s.entryPointsMap[messageID.ToMapKey()]
+
+	return exists
+}
+
 func solidEntryPointsFromBytes(solidEntryPointsBytes []byte) (*SolidEntryPoints, error) {
 	s := newSolidEntryPoints()
 
@@ -76,3 +83,8 @@ func (db *Database) loadSolidEntryPoints() error {
 
 	return nil
 }
+
+// SolidEntryPointsContain returns whether the given message is a solid entry point.
+func (db *Database) SolidEntryPointsContain(messageID hornet.MessageID) bool {
+	return db.solidEntryPoints.Contains(messageID)
+}
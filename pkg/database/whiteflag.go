@@ -0,0 +1,87 @@
+package database
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+)
+
+var (
+	ErrMessageNotFound    = errors.New("message not found")
+	ErrMessageNotIncluded = errors.New("message not included in the ledger")
+)
+
+// MessageMetadataConsumer is a function that consumes message metadata.
+type MessageMetadataConsumer func(msgMeta *MessageMetadata) bool
+
+// ForEachMilestoneReferencedMessage calls the consumer for every message referenced by the given milestone in white-flag order.
+// The order is given by a depth-first post-order traversal of the past cone of the milestone message,
+// which only follows messages that were referenced by this milestone. The milestone message itself is consumed last.
+func (db *Database) ForEachMilestoneReferencedMessage(milestoneIndex milestone.Index, consumer MessageMetadataConsumer) error {
+	ms := db.MilestoneOrNil(milestoneIndex)
+	if ms == nil {
+		return fmt.Errorf("%w: %d", ErrMilestoneNotFound, milestoneIndex)
+	}
+
+	processed := make(map[string]struct{})
+	stack := hornet.MessageIDs{ms.MessageID}
+
+	for len(stack) > 0 {
+		messageID := stack[len(stack)-1]
+		messageIDMapKey := messageID.ToMapKey()
+
+		if _, wasProcessed := processed[messageIDMapKey]; wasProcessed {
+			stack = stack[:len(stack)-1]
+
+			continue
+		}
+
+		if db.SolidEntryPointsContain(messageID) {
+			processed[messageIDMapKey] = struct{}{}
+			stack = stack[:len(stack)-1]
+
+			continue
+		}
+
+		msgMeta := db.MessageMetadataOrNil(messageID)
+		if msgMeta == nil {
+			return fmt.Errorf("%w: %s", ErrMessageNotFound, messageID.ToHex())
+		}
+
+		if referenced, referencedIndex := msgMeta.ReferencedWithIndex(); !referenced || referencedIndex != milestoneIndex {
+			// the message was referenced by an older milestone
+			processed[messageIDMapKey] = struct{}{}
+			stack = stack[:len(stack)-1]
+
+			continue
+		}
+
+		parentsTraversed := true
+		for _, parent := range msgMeta.Parents() {
+			if _, parentProcessed := processed[parent.ToMapKey()]; !parentProcessed {
+				// traverse the parent first
+				stack = append(stack, parent)
+				parentsTraversed = false
+
+				break
+			}
+		}
+
+		if !parentsTraversed {
+			continue
+		}
+
+		// all parents were already traversed
+		processed[messageIDMapKey] = struct{}{}
+		stack = stack[:len(stack)-1]
+
+		if !consumer(msgMeta) {
+			return nil
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package keymanager
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+var (
+	// ErrNoPublicKeysForMilestoneIndex is returned if no milestone public keys are configured for a milestone index.
+	ErrNoPublicKeysForMilestoneIndex = errors.New("no milestone public keys configured for milestone index")
+)
+
+// KeyRange defines the milestone index range in which a milestone public key is applicable.
+type KeyRange struct {
+	// The public key of the coordinator.
+	PublicKey iotago.MilestonePublicKey
+	// The first milestone index the public key is applicable for.
+	StartIndex milestone.Index
+	// The last milestone index the public key is applicable for (0 = no end).
+	EndIndex milestone.Index
+	// The minimum amount of valid signatures a milestone in this range needs.
+	Threshold int
+}
+
+// applicable returns whether the key range contains the given milestone index.
+func (r *KeyRange) applicable(msIndex milestone.Index) bool {
+	return msIndex >= r.StartIndex && (r.EndIndex == 0 || msIndex <= r.EndIndex)
+}
+
+// KeyManager provides the milestone public keys and signature thresholds that are applicable for a milestone index.
+type KeyManager struct {
+	keyRanges []*KeyRange
+}
+
+// New creates a new KeyManager.
+func New() *KeyManager {
+	return &KeyManager{
+		keyRanges: make([]*KeyRange, 0),
+	}
+}
+
+// AddKeyRange adds a hex encoded milestone public key that is applicable for the given milestone index range.
+func (k *KeyManager) AddKeyRange(publicKeyHex string, startIndex milestone.Index, endIndex milestone.Index, threshold int) error {
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	if err != nil {
+		return fmt.Errorf("can't decode milestone public key %s: %w", publicKeyHex, err)
+	}
+
+	if len(publicKeyBytes) != ed25519.PublicKeySize {
+		return fmt.Errorf("invalid milestone public key length %d, expected: %d", len(publicKeyBytes), ed25519.PublicKeySize)
+	}
+
+	if endIndex != 0 && endIndex < startIndex {
+		return fmt.Errorf("invalid milestone public key range %d-%d", startIndex, endIndex)
+	}
+
+	if threshold < 1 {
+		return fmt.Errorf("invalid milestone signature threshold %d for milestone public key %s", threshold, publicKeyHex)
+	}
+
+	keyRange := &KeyRange{
+		StartIndex: startIndex,
+		EndIndex:   endIndex,
+		Threshold:  threshold,
+	}
+	copy(keyRange.PublicKey[:], publicKeyBytes)
+
+	k.keyRanges = append(k.keyRanges, keyRange)
+
+	return nil
+}
+
+// PublicKeysSetForMilestoneIndex returns the set of milestone public keys that are applicable for the given milestone index.
+func (k *KeyManager) PublicKeysSetForMilestoneIndex(msIndex milestone.Index) iotago.MilestonePublicKeySet {
+	publicKeysSet := make(iotago.MilestonePublicKeySet)
+	for _, keyRange := range k.keyRanges {
+		if keyRange.applicable(msIndex) {
+			publicKeysSet[keyRange.PublicKey] = struct{}{}
+		}
+	}
+
+	return publicKeysSet
+}
+
+// ThresholdForMilestoneIndex returns the minimum amount of valid signatures a milestone with the given index needs.
+// If several key ranges are applicable, the highest threshold is used.
+func (k *KeyManager) ThresholdForMilestoneIndex(msIndex milestone.Index) int {
+	threshold := 0
+	for _, keyRange := range k.keyRanges {
+		if keyRange.applicable(msIndex) && keyRange.Threshold > threshold {
+			threshold = keyRange.Threshold
+		}
+	}
+
+	return threshold
+}
+
+// VerifyMilestone verifies the signatures of the given milestone payload against the applicable milestone public keys.
+func (k *KeyManager) VerifyMilestone(ms *iotago.Milestone) error {
+	msIndex := milestone.Index(ms.Index)
+
+	publicKeysSet := k.PublicKeysSetForMilestoneIndex(msIndex)
+	if len(publicKeysSet) == 0 {
+		return fmt.Errorf("%w: %d", ErrNoPublicKeysForMilestoneIndex, msIndex)
+	}
+
+	return ms.VerifySignatures(k.ThresholdForMilestoneIndex(msIndex), publicKeysSet)
+}
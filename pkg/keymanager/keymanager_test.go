@@ -0,0 +1,170 @@
+package keymanager_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/keymanager"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	iotago "github.com/iotaledger/iota.go/v2"
+	"github.com/iotaledger/iota.go/v2/ed25519"
+)
+
+const (
+	// the key pair of test vector 1 of RFC 8032.
+	testSeedHex      = "9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60"
+	testPublicKeyHex = "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"
+
+	// a second, unrelated public key.
+	otherPublicKeyHex = "3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c"
+)
+
+func testPrivateKey(t *testing.T) ed25519.PrivateKey {
+	t.Helper()
+
+	seed, err := hex.DecodeString(testSeedHex)
+	require.NoError(t, err)
+
+	privateKey := ed25519.NewKeyFromSeed(seed)
+	require.Equal(t, testPublicKeyHex, hex.EncodeToString(privateKey.Public().(ed25519.PublicKey)))
+
+	return privateKey
+}
+
+func testPublicKey(t *testing.T, publicKeyHex string) iotago.MilestonePublicKey {
+	t.Helper()
+
+	publicKeyBytes, err := hex.DecodeString(publicKeyHex)
+	require.NoError(t, err)
+
+	var publicKey iotago.MilestonePublicKey
+	copy(publicKey[:], publicKeyBytes)
+
+	return publicKey
+}
+
+func testMilestone(t *testing.T, index milestone.Index, privateKey ed25519.PrivateKey) *iotago.Milestone {
+	t.Helper()
+
+	var publicKey iotago.MilestonePublicKey
+	copy(publicKey[:], privateKey.Public().(ed25519.PublicKey))
+
+	ms, err := iotago.NewMilestone(uint32(index), 1609459200, iotago.MilestoneParentMessageIDs{{}}, iotago.MilestoneInclusionMerkleProof{}, []iotago.MilestonePublicKey{publicKey})
+	require.NoError(t, err)
+	require.NoError(t, ms.Sign(iotago.InMemoryEd25519MilestoneSigner(iotago.MilestonePublicKeyMapping{publicKey: privateKey})))
+
+	return ms
+}
+
+func TestAddKeyRange(t *testing.T) {
+	tests := []struct {
+		name         string
+		publicKeyHex string
+		startIndex   milestone.Index
+		endIndex     milestone.Index
+		threshold    int
+		wantErr      bool
+	}{
+		{name: "valid", publicKeyHex: testPublicKeyHex, startIndex: 1, endIndex: 100, threshold: 1},
+		{name: "valid without end", publicKeyHex: testPublicKeyHex, startIndex: 1, endIndex: 0, threshold: 1},
+		{name: "single milestone", publicKeyHex: testPublicKeyHex, startIndex: 10, endIndex: 10, threshold: 1},
+		{name: "invalid hex", publicKeyHex: "xyz", startIndex: 1, threshold: 1, wantErr: true},
+		{name: "invalid length", publicKeyHex: testPublicKeyHex[:62], startIndex: 1, threshold: 1, wantErr: true},
+		{name: "end before start", publicKeyHex: testPublicKeyHex, startIndex: 100, endIndex: 99, threshold: 1, wantErr: true},
+		{name: "zero threshold", publicKeyHex: testPublicKeyHex, startIndex: 1, threshold: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := keymanager.New().AddKeyRange(tt.publicKeyHex, tt.startIndex, tt.endIndex, tt.threshold)
+			if tt.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestKeyRanges(t *testing.T) {
+	keyManager := keymanager.New()
+	require.NoError(t, keyManager.AddKeyRange(testPublicKeyHex, 1, 100, 1))
+	require.NoError(t, keyManager.AddKeyRange(otherPublicKeyHex, 50, 0, 2))
+
+	testKey := testPublicKey(t, testPublicKeyHex)
+	otherKey := testPublicKey(t, otherPublicKeyHex)
+
+	tests := []struct {
+		name              string
+		msIndex           milestone.Index
+		expectedKeys      []iotago.MilestonePublicKey
+		expectedThreshold int
+	}{
+		{name: "before all ranges", msIndex: 0, expectedKeys: nil, expectedThreshold: 0},
+		{name: "first range start", msIndex: 1, expectedKeys: []iotago.MilestonePublicKey{testKey}, expectedThreshold: 1},
+		{name: "before overlap", msIndex: 49, expectedKeys: []iotago.MilestonePublicKey{testKey}, expectedThreshold: 1},
+		{name: "overlap start", msIndex: 50, expectedKeys: []iotago.MilestonePublicKey{testKey, otherKey}, expectedThreshold: 2},
+		{name: "first range end", msIndex: 100, expectedKeys: []iotago.MilestonePublicKey{testKey, otherKey}, expectedThreshold: 2},
+		{name: "open range", msIndex: 1000, expectedKeys: []iotago.MilestonePublicKey{otherKey}, expectedThreshold: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expectedKeys := make(iotago.MilestonePublicKeySet)
+			for _, key := range tt.expectedKeys {
+				expectedKeys[key] = struct{}{}
+			}
+
+			require.Equal(t, expectedKeys, keyManager.PublicKeysSetForMilestoneIndex(tt.msIndex))
+			require.Equal(t, tt.expectedThreshold, keyManager.ThresholdForMilestoneIndex(tt.msIndex))
+		})
+	}
+}
+
+func TestVerifyMilestone(t *testing.T) {
+	privateKey := testPrivateKey(t)
+
+	keyManager := keymanager.New()
+	require.NoError(t, keyManager.AddKeyRange(testPublicKeyHex, 1, 100, 1))
+	require.NoError(t, keyManager.AddKeyRange(otherPublicKeyHex, 101, 0, 1))
+
+	tampered := testMilestone(t, 10, privateKey)
+	tampered.Timestamp++
+
+	tests := []struct {
+		name      string
+		milestone *iotago.Milestone
+		wantErr   error
+	}{
+		{name: "valid", milestone: testMilestone(t, 10, privateKey)},
+		{name: "no public keys configured", milestone: testMilestone(t, 0, privateKey), wantErr: keymanager.ErrNoPublicKeysForMilestoneIndex},
+		{name: "public key not applicable", milestone: testMilestone(t, 101, privateKey), wantErr: iotago.ErrMilestoneNonApplicablePublicKey},
+		{name: "invalid signature", milestone: tampered, wantErr: iotago.ErrMilestoneInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := keyManager.VerifyMilestone(tt.milestone)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestVerifyMilestoneThreshold(t *testing.T) {
+	privateKey := testPrivateKey(t)
+
+	keyManager := keymanager.New()
+	require.NoError(t, keyManager.AddKeyRange(testPublicKeyHex, 1, 0, 2))
+	require.NoError(t, keyManager.AddKeyRange(otherPublicKeyHex, 1, 0, 2))
+
+	// the milestone is only signed by one of the two applicable keys
+	require.ErrorIs(t, keyManager.VerifyMilestone(testMilestone(t, 10, privateKey)), iotago.ErrMilestoneTooFewSignaturesForVerificationThreshold)
+}
@@ -0,0 +1,113 @@
+package merkle
+
+import (
+	"bytes"
+	"math/bits"
+
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+)
+
+// Domain separation prefixes of the leaf and node hashes, as defined in RFC 6962.
+const (
+	LeafHashPrefix = 0
+	NodeHashPrefix = 1
+)
+
+// AuditPathNode is a node of the audit path from a leaf to the root of a merkle tree.
+type AuditPathNode struct {
+	// The hash of the sibling subtree.
+	Hash []byte
+	// Whether the sibling subtree is the left child of its parent.
+	Left bool
+}
+
+// Hasher computes the merkle tree hash of the white-flag inclusion set of a milestone (RFC-0012).
+// It uses BLAKE2b-256 with the domain separation of RFC 6962.
+type Hasher struct{}
+
+// NewHasher creates a new Hasher.
+func NewHasher() *Hasher {
+	return &Hasher{}
+}
+
+// EmptyRoot returns the hash of an empty tree.
+func (t *Hasher) EmptyRoot() []byte {
+	h := blake2b.Sum256(nil)
+
+	return h[:]
+}
+
+// TreeHash computes the merkle tree hash of the given message IDs.
+func (t *Hasher) TreeHash(messageIDs hornet.MessageIDs) []byte {
+	data := make([][]byte, len(messageIDs))
+	for i, messageID := range messageIDs {
+		data[i] = messageID
+	}
+
+	return t.Hash(data)
+}
+
+// Hash computes the merkle tree hash of the given data.
+func (t *Hasher) Hash(data [][]byte) []byte {
+	switch len(data) {
+	case 0:
+		return t.EmptyRoot()
+	case 1:
+		return t.HashLeaf(data[0])
+	}
+
+	k := largestPowerOfTwo(len(data))
+
+	return t.HashNode(t.Hash(data[:k]), t.Hash(data[k:]))
+}
+
+// AuditPath returns the audit path of the leaf with the given index, starting at the leaf.
+func (t *Hasher) AuditPath(data [][]byte, index int) ([]*AuditPathNode, error) {
+	return t.NewTree(data).AuditPath(index)
+}
+
+// RootFromAuditPath computes the merkle tree hash from a leaf and its audit path.
+func (t *Hasher) RootFromAuditPath(leaf []byte, auditPath []*AuditPathNode) []byte {
+	hash := t.HashLeaf(leaf)
+	for _, node := range auditPath {
+		if node.Left {
+			hash = t.HashNode(node.Hash, hash)
+
+			continue
+		}
+		hash = t.HashNode(hash, node.Hash)
+	}
+
+	return hash
+}
+
+// VerifyAuditPath checks whether the audit path of the given leaf leads to the given merkle tree hash.
+func (t *Hasher) VerifyAuditPath(root []byte, leaf []byte, auditPath []*AuditPathNode) bool {
+	return bytes.Equal(root, t.RootFromAuditPath(leaf, auditPath))
+}
+
+// HashLeaf returns the hash of a leaf.
+func (t *Hasher) HashLeaf(leaf []byte) []byte {
+	h := blake2b.Sum256(append([]byte{LeafHashPrefix}, leaf...))
+
+	return h[:]
+}
+
+// HashNode returns the hash of an inner node with the given children.
+func (t *Hasher) HashNode(left []byte, right []byte) []byte {
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, NodeHashPrefix)
+	data = append(data, left...)
+	data = append(data, right...)
+
+	h := blake2b.Sum256(data)
+
+	return h[:]
+}
+
+// largestPowerOfTwo returns the largest power of two that is strictly less than x (x > 1).
+func largestPowerOfTwo(x int) int {
+	return 1 << (bits.Len(uint(x-1)) - 1)
+}
@@ -0,0 +1,99 @@
+package merkle_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/merkle"
+)
+
+// the white-flag test vector of Hornet (RFC-0012).
+var testMessageIDs = []string{
+	"52fdfc072182654f163f5f0f9a621d729566c74d10037c4d7bbb0407d1e2c649",
+	"81855ad8681d0d86d1e91e00167939cb6694d2c422acd208a0072939487f6999",
+	"eb9d18a44784045d87f3c67cf22746e995af5a25367951baa2ff6cd471c483f1",
+	"5fb90badb37c5821b6d95526a41a9504680b4e7c8b763a1b1d49d4955c848621",
+	"6325253fec738dd7a9e28bf921119c160f0702448615bbda08313f6a8eb668d2",
+	"0bf5059875921e668a5bdf2c7fc4844592d2572bcd0668d2d6c52f5054e2d083",
+	"6bf84c7174cb7476364cc3dbd968b0f7172ed85794bb358b0c3b525da1786f9f",
+}
+
+func testMessageIDsData(t *testing.T, count int) [][]byte {
+	t.Helper()
+
+	data := make([][]byte, count)
+	for i := 0; i < count; i++ {
+		messageID, err := hornet.MessageIDFromHex(testMessageIDs[i])
+		require.NoError(t, err)
+		data[i] = messageID
+	}
+
+	return data
+}
+
+func TestTreeHash(t *testing.T) {
+	tests := []struct {
+		name         string
+		messageCount int
+		expectedRoot string
+	}{
+		{
+			name:         "empty",
+			messageCount: 0,
+			expectedRoot: "0e5751c026e543b2e8ab2eb06099daa1d1e5df47778f7787faab45cdf12fe3a8",
+		},
+		{
+			name:         "hornet test vector",
+			messageCount: len(testMessageIDs),
+			expectedRoot: "bf67ce7ba23e8c0951b5abaec4f5524360d2c26d971ff226d3359fa70cdb0beb",
+		},
+	}
+
+	hasher := merkle.NewHasher()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := testMessageIDsData(t, tt.messageCount)
+
+			messageIDs := make(hornet.MessageIDs, len(data))
+			for i := range data {
+				messageIDs[i] = data[i]
+			}
+
+			require.Equal(t, tt.expectedRoot, hex.EncodeToString(hasher.TreeHash(messageIDs)))
+			require.Equal(t, tt.expectedRoot, hex.EncodeToString(hasher.NewTree(data).Root()))
+		})
+	}
+}
+
+func TestAuditPath(t *testing.T) {
+	hasher := merkle.NewHasher()
+
+	// every tree size up to the size of the test vector, to cover perfect and unbalanced trees
+	for size := 1; size <= len(testMessageIDs); size++ {
+		data := testMessageIDsData(t, size)
+		root := hasher.Hash(data)
+		tree := hasher.NewTree(data)
+		require.Equal(t, root, tree.Root())
+
+		for index := 0; index < size; index++ {
+			auditPath, err := hasher.AuditPath(data, index)
+			require.NoError(t, err)
+			require.True(t, hasher.VerifyAuditPath(root, data[index], auditPath), "size: %d, index: %d", size, index)
+
+			// the audit path must not verify another leaf
+			otherIndex := (index + 1) % size
+			if otherIndex != index {
+				require.False(t, hasher.VerifyAuditPath(root, data[otherIndex], auditPath), "size: %d, index: %d", size, index)
+			}
+		}
+
+		_, err := tree.AuditPath(size)
+		require.Error(t, err)
+
+		_, err = tree.AuditPath(-1)
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,92 @@
+package merkle
+
+import (
+	"fmt"
+)
+
+// Tree is a merkle tree that keeps the hashes of all perfect subtrees,
+// so that the root and audit paths can be computed without hashing the leaves again.
+type Tree struct {
+	hasher *Hasher
+	// levels[h][i] is the hash of the perfect subtree over the leaves [i*2^h, (i+1)*2^h).
+	levels [][][]byte
+	// the amount of leaves.
+	size int
+}
+
+// NewTree creates a new merkle tree over the given data.
+func (t *Hasher) NewTree(data [][]byte) *Tree {
+	leaves := make([][]byte, len(data))
+	for i, leaf := range data {
+		leaves[i] = t.HashLeaf(leaf)
+	}
+
+	levels := [][][]byte{leaves}
+	for level := leaves; len(level) > 1; {
+		parents := make([][]byte, len(level)/2)
+		for i := range parents {
+			parents[i] = t.HashNode(level[2*i], level[2*i+1])
+		}
+		levels = append(levels, parents)
+		level = parents
+	}
+
+	return &Tree{
+		hasher: t,
+		levels: levels,
+		size:   len(data),
+	}
+}
+
+// Size returns the amount of leaves of the tree.
+func (t *Tree) Size() int {
+	return t.size
+}
+
+// Root returns the merkle tree hash.
+func (t *Tree) Root() []byte {
+	if t.size == 0 {
+		return t.hasher.EmptyRoot()
+	}
+
+	return t.subtreeHash(0, t.size)
+}
+
+// AuditPath returns the audit path of the leaf with the given index, starting at the leaf.
+func (t *Tree) AuditPath(index int) ([]*AuditPathNode, error) {
+	if index < 0 || index >= t.size {
+		return nil, fmt.Errorf("leaf index %d out of range, leaf count: %d", index, t.size)
+	}
+
+	return t.auditPath(0, t.size, index), nil
+}
+
+func (t *Tree) auditPath(start int, n int, index int) []*AuditPathNode {
+	if n <= 1 {
+		return make([]*AuditPathNode, 0)
+	}
+
+	k := largestPowerOfTwo(n)
+	if index < k {
+		return append(t.auditPath(start, k, index), &AuditPathNode{Hash: t.subtreeHash(start+k, n-k), Left: false})
+	}
+
+	return append(t.auditPath(start+k, n-k, index-k), &AuditPathNode{Hash: t.subtreeHash(start, k), Left: true})
+}
+
+// subtreeHash returns the merkle tree hash of the n leaves starting at start.
+// The left subtrees of the recursive split are always perfect and aligned to their size, so they can be looked up.
+func (t *Tree) subtreeHash(start int, n int) []byte {
+	if n&(n-1) == 0 {
+		level := 0
+		for size := n; size > 1; size >>= 1 {
+			level++
+		}
+
+		return t.levels[level][start/n]
+	}
+
+	k := largestPowerOfTwo(n)
+
+	return t.hasher.HashNode(t.subtreeHash(start, k), t.subtreeHash(start+k, n-k))
+}
@@ -30,6 +30,24 @@ const (
 
 	// ParameterMilestoneIndex is used to identify a milestone.
 	ParameterMilestoneIndex = "milestoneIndex"
+
+	// ParameterSearchQuery is used to search for an arbitrary resource.
+	ParameterSearchQuery = "query"
+
+	// QueryParameterAt is used to query the state of the ledger at a given milestone index.
+	QueryParameterAt = "at"
+
+	// QueryParameterDepth is used to limit the depth of a traversal.
+	QueryParameterDepth = "depth"
+
+	// QueryParameterLimit is used to limit the amount of results.
+	QueryParameterLimit = "limit"
+
+	// QueryParameterStartIndex is used to specify the first milestone index of a range.
+	QueryParameterStartIndex = "startIndex"
+
+	// QueryParameterEndIndex is used to specify the last milestone index of a range.
+	QueryParameterEndIndex = "endIndex"
 )
 
 var (
@@ -152,3 +170,39 @@ func ParseMilestoneIndexParam(c echo.Context) (milestone.Index, error) {
 
 	return milestone.Index(msIndex), nil
 }
+
+// ParseMilestoneIndexQueryParam parses an optional milestone index query parameter.
+// It returns nil if the query parameter is not specified.
+//
+//nolint:nilnil // nil is returned if the query parameter is not specified
+func ParseMilestoneIndexQueryParam(c echo.Context, paramName string) (*milestone.Index, error) {
+	milestoneIndex := strings.ToLower(c.QueryParam(paramName))
+	if milestoneIndex == "" {
+		return nil, nil
+	}
+
+	msIndex, err := strconv.ParseUint(milestoneIndex, 10, 32)
+	if err != nil {
+		return nil, errors.WithMessagef(ErrInvalidParameter, "invalid milestone index: %s, error: %s", milestoneIndex, err)
+	}
+
+	index := milestone.Index(msIndex)
+
+	return &index, nil
+}
+
+// ParseUint32QueryParam parses an optional uint32 query parameter.
+// It returns the default value if the query parameter is not specified.
+func ParseUint32QueryParam(c echo.Context, paramName string, defaultValue uint32) (uint32, error) {
+	param := strings.ToLower(c.QueryParam(paramName))
+	if param == "" {
+		return defaultValue, nil
+	}
+
+	value, err := strconv.ParseUint(param, 10, 32)
+	if err != nil {
+		return 0, errors.WithMessagef(ErrInvalidParameter, "invalid value for query parameter \"%s\": %s, error: %s", paramName, param, err)
+	}
+
+	return uint32(value), nil
+}
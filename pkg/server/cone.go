@@ -0,0 +1,210 @@
+package server
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+)
+
+const (
+	// DefaultConeDepth is the default depth of a cone traversal.
+	DefaultConeDepth = 10
+)
+
+// parseLimitQueryParam parses the optional "limit" query parameter.
+// The limit never exceeds the maximum amount of results of the API.
+func (s *DatabaseServer) parseLimitQueryParam(c echo.Context) (int, error) {
+	limit, err := restapi.ParseUint32QueryParam(c, restapi.QueryParameterLimit, uint32(s.RestAPILimitsMaxResults))
+	if err != nil {
+		return 0, err
+	}
+
+	if limit == 0 || int(limit) > s.RestAPILimitsMaxResults {
+		return s.RestAPILimitsMaxResults, nil
+	}
+
+	return int(limit), nil
+}
+
+// pastConeByMessageID walks the parents of a message breadth-first.
+// The traversal stops at solid entry points and at messages that are not available anymore because they were pruned.
+func (s *DatabaseServer) pastConeByMessageID(c echo.Context) (*messagePastConeResponse, error) {
+	messageID, err := restapi.ParseMessageIDParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	depth, err := restapi.ParseUint32QueryParam(c, restapi.QueryParameterDepth, DefaultConeDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := s.parseLimitQueryParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Database.MessageMetadataOrNil(messageID) == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "message not found: %s", messageID.ToHex())
+	}
+
+	messages := make([]*messageConeEntry, 0)
+	visited := map[string]struct{}{messageID.ToMapKey(): {}}
+	currentMessageIDs := hornet.MessageIDs{messageID}
+
+	for currentDepth := uint32(0); len(currentMessageIDs) > 0 && len(messages) < limit; currentDepth++ {
+		var nextMessageIDs hornet.MessageIDs
+
+		for _, currentMessageID := range currentMessageIDs {
+			if len(messages) >= limit {
+				break
+			}
+
+			entry := &messageConeEntry{
+				MessageID: currentMessageID.ToHex(),
+				Depth:     currentDepth,
+			}
+			messages = append(messages, entry)
+
+			if s.Database.SolidEntryPointsContain(currentMessageID) {
+				entry.SolidEntryPoint = true
+
+				continue
+			}
+
+			msgMeta := s.Database.MessageMetadataOrNil(currentMessageID)
+			if msgMeta == nil {
+				entry.Pruned = true
+
+				continue
+			}
+
+			if referenced, referencedIndex := msgMeta.ReferencedWithIndex(); referenced {
+				entry.ReferencedByMilestoneIndex = &referencedIndex
+			}
+
+			if currentDepth >= depth {
+				continue
+			}
+
+			for _, parent := range msgMeta.Parents() {
+				if _, seen := visited[parent.ToMapKey()]; seen {
+					continue
+				}
+				visited[parent.ToMapKey()] = struct{}{}
+
+				nextMessageIDs = append(nextMessageIDs, parent)
+			}
+		}
+
+		currentMessageIDs = nextMessageIDs
+	}
+
+	return &messagePastConeResponse{
+		MessageID:  messageID.ToHex(),
+		Depth:      depth,
+		MaxResults: uint32(limit),
+		Count:      uint32(len(messages)),
+		Messages:   messages,
+	}, nil
+}
+
+// futureConeByMessageID walks the children of a message breadth-first until the milestone that referenced the message is reached.
+// Only children referenced by the same milestone can be part of the approval path, all other children are skipped.
+// Only the first RestAPILimitsMaxResults children of every message are considered, so the path might not be found.
+func (s *DatabaseServer) futureConeByMessageID(messageID hornet.MessageID) (*messageFutureConeResponse, error) {
+	msgMeta := s.Database.MessageMetadataOrNil(messageID)
+	if msgMeta == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "message not found: %s", messageID.ToHex())
+	}
+
+	referenced, referencedIndex := msgMeta.ReferencedWithIndex()
+	if !referenced {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "message not referenced by a milestone: %s", messageID.ToHex())
+	}
+
+	ms := s.Database.MilestoneOrNil(referencedIndex)
+	if ms == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "milestone not found: %d", referencedIndex)
+	}
+
+	milestoneMessageKey := ms.MessageID.ToMapKey()
+
+	// predecessors contains the message from which a message was reached first
+	predecessors := map[string]hornet.MessageID{messageID.ToMapKey(): nil}
+	currentMessageIDs := hornet.MessageIDs{messageID}
+
+	found := messageID.ToMapKey() == milestoneMessageKey
+	childrenTruncated := false
+	for !found && len(currentMessageIDs) > 0 {
+		var nextMessageIDs hornet.MessageIDs
+
+		for _, currentMessageID := range currentMessageIDs {
+			childrenMessageIDs, err := s.Database.ChildrenMessageIDs(currentMessageID, s.RestAPILimitsMaxResults)
+			if err != nil {
+				return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+			}
+
+			// ChildrenMessageIDs returns one more child than requested if there are more children
+			if len(childrenMessageIDs) > s.RestAPILimitsMaxResults {
+				childrenMessageIDs = childrenMessageIDs[:s.RestAPILimitsMaxResults]
+				childrenTruncated = true
+			}
+
+			for _, childMessageID := range childrenMessageIDs {
+				if _, seen := predecessors[childMessageID.ToMapKey()]; seen {
+					continue
+				}
+
+				childMeta := s.Database.MessageMetadataOrNil(childMessageID)
+				if childMeta == nil {
+					continue
+				}
+
+				if childReferenced, childReferencedIndex := childMeta.ReferencedWithIndex(); !childReferenced || childReferencedIndex != referencedIndex {
+					continue
+				}
+
+				predecessors[childMessageID.ToMapKey()] = currentMessageID
+				nextMessageIDs = append(nextMessageIDs, childMessageID)
+
+				if childMessageID.ToMapKey() == milestoneMessageKey {
+					found = true
+
+					break
+				}
+			}
+
+			if found {
+				break
+			}
+		}
+
+		currentMessageIDs = nextMessageIDs
+	}
+
+	if !found {
+		if childrenTruncated {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "approval path to milestone %d not found within the first %d children of every message: %s", referencedIndex, s.RestAPILimitsMaxResults, messageID.ToHex())
+		}
+
+		// children of the message might not be available anymore because they were pruned
+		return nil, errors.WithMessagef(echo.ErrNotFound, "approval path to milestone %d not found: %s", referencedIndex, messageID.ToHex())
+	}
+
+	// walk back from the milestone to the message
+	var path hornet.MessageIDs
+	for current := ms.MessageID; current != nil; current = predecessors[current.ToMapKey()] {
+		path = append(hornet.MessageIDs{current}, path...)
+	}
+
+	return &messageFutureConeResponse{
+		MessageID:                  messageID.ToHex(),
+		ReferencedByMilestoneIndex: referencedIndex,
+		MilestoneMessageID:         ms.MessageID.ToHex(),
+		Hops:                       uint32(len(path) - 1),
+		Path:                       path.ToHex(),
+	}, nil
+}
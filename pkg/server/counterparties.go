@@ -0,0 +1,218 @@
+package server
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+// addressCounterparties aggregates the addresses the given address sent funds to or received funds from.
+// Funds sent are the outputs of the transactions spending the address, without the remainder sent back to the address.
+// Transactions have no notion of which input funded which output, so if several addresses spent funds in the same transaction,
+// the sent and received funds are attributed proportionally to the inputs of every sender.
+// Inputs that are not available anymore (e.g. pruned) belong to an unknown sender and their share is not attributed.
+// The share of the inputs of the address itself is not attributed to any counterparty.
+// At most RestAPILimitsMaxResults transactions are examined.
+func (s *DatabaseServer) addressCounterparties(address iotago.Address) (*addressCounterpartiesResponse, error) {
+	maxResults := s.RestAPILimitsMaxResults
+	ledgerIndex := s.UTXOManager.ReadLedgerIndex()
+
+	addressBech32 := address.Bech32(s.Bech32HRP)
+
+	counterpartiesByAddress := make(map[string]*addressCounterparty)
+	counterparty := func(bech32 string) *addressCounterparty {
+		if c, exists := counterpartiesByAddress[bech32]; exists {
+			return c
+		}
+
+		c := &addressCounterparty{Address: bech32}
+		counterpartiesByAddress[bech32] = c
+
+		return c
+	}
+
+	// transactions that spent funds of the address, with the spent amount
+	spendingTransactionAmounts := make(map[iotago.TransactionID]uint64)
+
+	// transactions that created outputs on the address, with the message that contains them and the received amount
+	receivingTransactionMessages := make(map[iotago.TransactionID]hornet.MessageID)
+	receivingTransactionAmounts := make(map[iotago.TransactionID]uint64)
+
+	truncated := false
+
+	// addTransactions returns false if a transaction would exceed the maximum count of examined transactions.
+	addTransactions := func(output *utxo.Output, spent *utxo.Spent) bool {
+		var transactionID iotago.TransactionID
+		copy(transactionID[:], output.OutputID()[:iotago.TransactionIDLength])
+
+		newTransactions := 0
+		if _, exists := receivingTransactionMessages[transactionID]; !exists {
+			newTransactions++
+		}
+		if spent != nil {
+			if _, exists := spendingTransactionAmounts[*spent.TargetTransactionID()]; !exists {
+				newTransactions++
+			}
+		}
+
+		if len(receivingTransactionMessages)+len(spendingTransactionAmounts)+newTransactions > maxResults {
+			truncated = true
+
+			return false
+		}
+
+		receivingTransactionMessages[transactionID] = output.MessageID()
+		receivingTransactionAmounts[transactionID] += output.Amount()
+
+		if spent != nil {
+			spendingTransactionAmounts[*spent.TargetTransactionID()] += output.Amount()
+		}
+
+		return true
+	}
+
+	if err := s.UTXOManager.ForEachUnspentOutput(func(output *utxo.Output) bool {
+		return addTransactions(output, nil)
+	}, utxo.FilterAddress(address)); err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading unspent outputs failed: %s, error: %s", address, err)
+	}
+
+	if !truncated {
+		if err := s.UTXOManager.ForEachSpentOutput(func(spent *utxo.Spent) bool {
+			return addTransactions(spent.Output(), spent)
+		}, utxo.FilterAddress(address)); err != nil {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading spent outputs failed: %s, error: %s", address, err)
+		}
+	}
+
+	for transactionID, spentAmount := range spendingTransactionAmounts {
+		transactionID := transactionID
+
+		transactionOutputs, err := s.UTXOManager.TransactionOutputs(&transactionID)
+		if err != nil {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading outputs of transaction failed: %s, error: %s", hex.EncodeToString(transactionID[:]), err)
+		}
+
+		// the sum of the inputs of a confirmed transaction always equals the sum of its outputs
+		var inputAmount uint64
+		sentAmounts := make(map[string]uint64)
+		for _, output := range transactionOutputs {
+			inputAmount += output.Amount()
+
+			recipient := output.Address().Bech32(s.Bech32HRP)
+			if recipient == addressBech32 {
+				// remainder
+				continue
+			}
+			sentAmounts[recipient] += output.Amount()
+		}
+
+		for recipient, amount := range sentAmounts {
+			c := counterparty(recipient)
+			c.SentAmount += proportionalAmount(amount, spentAmount, inputAmount)
+			c.SentCount++
+		}
+	}
+
+	for transactionID, messageID := range receivingTransactionMessages {
+		msg := s.Database.MessageOrNil(messageID)
+		if msg == nil {
+			continue
+		}
+
+		essence := msg.TransactionEssence()
+		if essence == nil {
+			// outputs created by receipts have no sender
+			continue
+		}
+
+		inputOutputs, err := s.transactionInputOutputs(essence)
+		if err != nil {
+			return nil, err
+		}
+
+		transactionID := transactionID
+
+		transactionOutputs, err := s.UTXOManager.TransactionOutputs(&transactionID)
+		if err != nil {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading outputs of transaction failed: %s, error: %s", hex.EncodeToString(transactionID[:]), err)
+		}
+
+		// the inputs are weighted against the sum of all inputs, so the share of unavailable inputs is not attributed
+		var inputAmount uint64
+		for _, output := range transactionOutputs {
+			inputAmount += output.Amount()
+		}
+
+		inputAmounts := make(map[string]uint64)
+		for _, output := range inputOutputs {
+			inputAmounts[output.Address().Bech32(s.Bech32HRP)] += output.Amount()
+		}
+
+		for sender, amount := range inputAmounts {
+			if sender == addressBech32 {
+				// the share of the inputs of the address is a remainder the address sent to itself
+				continue
+			}
+
+			c := counterparty(sender)
+			c.ReceivedAmount += proportionalAmount(receivingTransactionAmounts[transactionID], amount, inputAmount)
+			c.ReceivedCount++
+		}
+	}
+
+	counterparties := make([]*addressCounterparty, 0, len(counterpartiesByAddress))
+	for _, c := range counterpartiesByAddress {
+		counterparties = append(counterparties, c)
+	}
+
+	sort.Slice(counterparties, func(i, j int) bool {
+		volumeI := counterparties[i].SentAmount + counterparties[i].ReceivedAmount
+		volumeJ := counterparties[j].SentAmount + counterparties[j].ReceivedAmount
+		if volumeI != volumeJ {
+			return volumeI > volumeJ
+		}
+
+		return counterparties[i].Address < counterparties[j].Address
+	})
+
+	if len(counterparties) > maxResults {
+		counterparties = counterparties[:maxResults]
+		truncated = true
+	}
+
+	return &addressCounterpartiesResponse{
+		AddressType:    address.Type(),
+		Address:        address.String(),
+		MaxResults:     uint32(maxResults),
+		Count:          uint32(len(counterparties)),
+		Truncated:      truncated,
+		Counterparties: counterparties,
+		LedgerIndex:    ledgerIndex,
+	}, nil
+}
+
+func (s *DatabaseServer) counterpartiesByBech32Address(c echo.Context) (*addressCounterpartiesResponse, error) {
+	bech32Address, err := restapi.ParseBech32AddressParam(c, s.Bech32HRP)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.addressCounterparties(bech32Address)
+}
+
+func (s *DatabaseServer) counterpartiesByEd25519Address(c echo.Context) (*addressCounterpartiesResponse, error) {
+	address, err := restapi.ParseEd25519AddressParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.addressCounterparties(address)
+}
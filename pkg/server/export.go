@@ -0,0 +1,215 @@
+package server
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+const (
+	MIMETextCSV = "text/csv"
+
+	addressHistoryEntryTypeCredit = "credit"
+	addressHistoryEntryTypeDebit  = "debit"
+)
+
+var addressHistoryCSVHeader = []string{
+	"milestoneIndex",
+	"milestoneTimestamp",
+	"type",
+	"transactionId",
+	"outputId",
+	"amount",
+	"balance",
+}
+
+// addressHistoryEntry is a single credit or debit of an address.
+type addressHistoryEntry struct {
+	milestoneIndex milestone.Index
+	entryType      string
+	transactionID  string
+	outputID       string
+	amount         uint64
+}
+
+func newAddressHistoryCredit(milestoneIndex milestone.Index, output *utxo.Output) *addressHistoryEntry {
+	return &addressHistoryEntry{
+		milestoneIndex: milestoneIndex,
+		entryType:      addressHistoryEntryTypeCredit,
+		transactionID:  hex.EncodeToString(output.OutputID()[:iotago.TransactionIDLength]),
+		outputID:       output.OutputID().ToHex(),
+		amount:         output.Amount(),
+	}
+}
+
+func newAddressHistoryDebit(spent *utxo.Spent) *addressHistoryEntry {
+	return &addressHistoryEntry{
+		milestoneIndex: spent.ConfirmationIndex(),
+		entryType:      addressHistoryEntryTypeDebit,
+		transactionID:  hex.EncodeToString(spent.TargetTransactionID()[:]),
+		outputID:       spent.OutputID().ToHex(),
+		amount:         spent.Amount(),
+	}
+}
+
+// addressHistoryCSVWriter writes the entries of the history of an address as CSV rows and keeps the running balance.
+type addressHistoryCSVWriter struct {
+	s         *DatabaseServer
+	csvWriter *csv.Writer
+	balance   int64
+
+	// the timestamp of the milestone of the last written entry, the entries are written in milestone order.
+	milestoneIndex     milestone.Index
+	milestoneTimestamp int64
+}
+
+func (w *addressHistoryCSVWriter) write(entry *addressHistoryEntry) error {
+	amount := int64(entry.amount)
+	if entry.entryType == addressHistoryEntryTypeDebit {
+		amount = -amount
+	}
+	w.balance += amount
+
+	if entry.milestoneIndex != w.milestoneIndex {
+		timestamp, err := w.s.milestoneTimestamp(entry.milestoneIndex)
+		if err != nil {
+			return err
+		}
+		w.milestoneIndex = entry.milestoneIndex
+		w.milestoneTimestamp = timestamp
+	}
+
+	// the timestamp is left empty if the milestone is unknown (e.g. the snapshot milestone)
+	var timestamp string
+	if w.milestoneTimestamp != 0 {
+		timestamp = strconv.FormatInt(w.milestoneTimestamp, 10)
+	}
+
+	return w.csvWriter.Write([]string{
+		strconv.FormatUint(uint64(entry.milestoneIndex), 10),
+		timestamp,
+		entry.entryType,
+		entry.transactionID,
+		entry.outputID,
+		strconv.FormatInt(amount, 10),
+		strconv.FormatInt(w.balance, 10),
+	})
+}
+
+// addressHistory returns all credits and debits of the address, ordered by milestone index.
+// The history is rebuilt from the outputs of the address only, so the work is bound by the amount of outputs of the address.
+// Outputs without a creating milestone after the snapshot index were part of the snapshot the ledger was started from,
+// therefore they are credited at the snapshot index. Spends before the snapshot index are debited at the snapshot index as well.
+func (s *DatabaseServer) addressHistory(address iotago.Address) ([]*addressHistoryEntry, error) {
+	snapshotIndex := s.Database.SnapshotInfo().SnapshotIndex
+
+	var entries []*addressHistoryEntry
+
+	addCredit := func(output *utxo.Output) {
+		milestoneIndexBooked, booked := s.outputMilestoneIndexBooked(output)
+		if !booked || milestoneIndexBooked < snapshotIndex {
+			milestoneIndexBooked = snapshotIndex
+		}
+
+		entries = append(entries, newAddressHistoryCredit(milestoneIndexBooked, output))
+	}
+
+	if err := s.UTXOManager.ForEachUnspentOutput(func(output *utxo.Output) bool {
+		addCredit(output)
+
+		return true
+	}, utxo.FilterAddress(address)); err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading unspent outputs failed: %s, error: %s", address, err)
+	}
+
+	if err := s.UTXOManager.ForEachSpentOutput(func(spent *utxo.Spent) bool {
+		addCredit(spent.Output())
+
+		debit := newAddressHistoryDebit(spent)
+		if debit.milestoneIndex < snapshotIndex {
+			debit.milestoneIndex = snapshotIndex
+		}
+		entries = append(entries, debit)
+
+		return true
+	}, utxo.FilterAddress(address)); err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading spent outputs failed: %s, error: %s", address, err)
+	}
+
+	// credits are sorted before debits of the same milestone, so the running balance never gets negative
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].milestoneIndex != entries[j].milestoneIndex {
+			return entries[i].milestoneIndex < entries[j].milestoneIndex
+		}
+
+		return entries[i].entryType == addressHistoryEntryTypeCredit && entries[j].entryType == addressHistoryEntryTypeDebit
+	})
+
+	return entries, nil
+}
+
+func (s *DatabaseServer) exportCSV(c echo.Context, address iotago.Address) error {
+	entries, err := s.addressHistory(address)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, MIMETextCSV)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", address.String()+".csv"))
+	c.Response().WriteHeader(http.StatusOK)
+
+	w := &addressHistoryCSVWriter{
+		s:         s,
+		csvWriter: csv.NewWriter(c.Response()),
+	}
+
+	err = w.csvWriter.Write(addressHistoryCSVHeader)
+	for _, entry := range entries {
+		if err != nil {
+			break
+		}
+		err = w.write(entry)
+	}
+	if err == nil {
+		w.csvWriter.Flush()
+		err = w.csvWriter.Error()
+	}
+
+	if err != nil {
+		// the status code was already sent, so the error can't be reported in the response anymore.
+		// the connection is aborted instead, so the client doesn't mistake the partial export for a complete one.
+		c.Logger().Errorf("exporting history of address %s failed: %s", address.String(), err)
+		panic(http.ErrAbortHandler)
+	}
+
+	return nil
+}
+
+func (s *DatabaseServer) exportCSVByBech32Address(c echo.Context) error {
+	bech32Address, err := restapi.ParseBech32AddressParam(c, s.Bech32HRP)
+	if err != nil {
+		return err
+	}
+
+	return s.exportCSV(c, bech32Address)
+}
+
+func (s *DatabaseServer) exportCSVByEd25519Address(c echo.Context) error {
+	address, err := restapi.ParseEd25519AddressParam(c)
+	if err != nil {
+		return err
+	}
+
+	return s.exportCSV(c, address)
+}
@@ -14,6 +14,20 @@ import (
 	iotago "github.com/iotaledger/iota.go/v2"
 )
 
+// ledgerInclusionState returns the ledger inclusion state of a referenced message and the reason if it is conflicting.
+func ledgerInclusionState(msgMeta *database.MessageMetadata) (string, *database.Conflict) {
+	conflict := msgMeta.Conflict()
+
+	switch {
+	case conflict != database.ConflictNone:
+		return "conflicting", &conflict
+	case msgMeta.IsIncludedTxInLedger():
+		return "included", nil
+	default:
+		return "noTransaction", nil
+	}
+}
+
 func (s *DatabaseServer) messageMetadataByMessageID(messageID hornet.MessageID) (*messageMetadataResponse, error) {
 
 	msgMeta := s.Database.MessageMetadataOrNil(messageID)
@@ -34,23 +48,25 @@ func (s *DatabaseServer) messageMetadataByMessageID(messageID hornet.MessageID)
 		ReferencedByMilestoneIndex: referencedByMilestone,
 	}
 
+	if referenced {
+		timestamp, err := s.milestoneTimestamp(referencedIndex)
+		if err != nil {
+			return nil, err
+		}
+		if timestamp != 0 {
+			messageMetadataResponse.ReferencedByMilestoneTimestamp = &timestamp
+		}
+	}
+
 	if msgMeta.IsMilestone() {
 		messageMetadataResponse.MilestoneIndex = referencedByMilestone
 	}
 
 	if referenced {
-		inclusionState := "noTransaction"
-
-		conflict := msgMeta.Conflict()
-
-		if conflict != database.ConflictNone {
-			inclusionState = "conflicting"
-			messageMetadataResponse.ConflictReason = &conflict
-		} else if msgMeta.IsIncludedTxInLedger() {
-			inclusionState = "included"
-		}
+		inclusionState, conflictReason := ledgerInclusionState(msgMeta)
 
 		messageMetadataResponse.LedgerInclusionState = &inclusionState
+		messageMetadataResponse.ConflictReason = conflictReason
 	}
 	/*
 		else if msgMeta.IsSolid() {
@@ -95,6 +111,29 @@ func (s *DatabaseServer) childrenIDsByMessageID(messageID hornet.MessageID) (*ch
 	}, nil
 }
 
+func (s *DatabaseServer) messageFullByMessageID(messageID hornet.MessageID) (*messageFullResponse, error) {
+	msg, err := s.messageByMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := s.messageMetadataByMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := s.childrenIDsByMessageID(messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messageFullResponse{
+		Message:  msg,
+		Metadata: metadata,
+		Children: children,
+	}, nil
+}
+
 func (s *DatabaseServer) messageIDsByIndex(c echo.Context) (*messageIDsByIndexResponse, error) {
 	maxResults := s.RestAPILimitsMaxResults
 	index := c.QueryParam("index")
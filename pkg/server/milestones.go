@@ -1,9 +1,14 @@
 package server
 
 import (
+	"encoding/hex"
+
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
+	"github.com/iotaledger/inx-api-core-v1/pkg/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/keymanager"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
 	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
 
 	"github.com/iotaledger/hive.go/core/kvstore"
@@ -25,6 +30,7 @@ func (s *DatabaseServer) milestoneByIndex(c echo.Context) (*milestoneResponse, e
 		Index:     uint32(ms.Index),
 		MessageID: ms.MessageID.ToHex(),
 		Time:      ms.Timestamp.Unix(),
+		Verified:  s.milestoneVerified(msIndex),
 	}, nil
 }
 
@@ -61,3 +67,96 @@ func (s *DatabaseServer) milestoneUTXOChangesByIndex(c echo.Context) (*milestone
 		ConsumedOutputs: consumedOutputs,
 	}, nil
 }
+
+func (s *DatabaseServer) milestoneReferencedMessagesByIndex(c echo.Context) (*milestoneReferencedMessagesResponse, error) {
+	maxResults := s.RestAPILimitsMaxResults
+
+	msIndex, err := restapi.ParseMilestoneIndexParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*milestoneReferencedMessage, 0)
+	if err := s.Database.ForEachMilestoneReferencedMessage(msIndex, func(msgMeta *database.MessageMetadata) bool {
+		inclusionState, conflictReason := ledgerInclusionState(msgMeta)
+
+		messages = append(messages, &milestoneReferencedMessage{
+			MessageID:            msgMeta.MessageID().ToHex(),
+			LedgerInclusionState: inclusionState,
+			ConflictReason:       conflictReason,
+		})
+
+		return len(messages) < maxResults
+	}); err != nil {
+		if errors.Is(err, database.ErrMilestoneNotFound) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "milestone not found: %d", msIndex)
+		}
+
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "traversing referenced messages of milestone %d failed, error: %s", msIndex, err)
+	}
+
+	return &milestoneReferencedMessagesResponse{
+		Index:      uint32(msIndex),
+		MaxResults: uint32(maxResults),
+		Count:      uint32(len(messages)),
+		Messages:   messages,
+	}, nil
+}
+
+func (s *DatabaseServer) milestoneVerifyByIndex(c echo.Context) (*milestoneVerifyResponse, error) {
+
+	msIndex, err := restapi.ParseMilestoneIndexParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	verification, err := s.Database.VerifyMilestoneInclusionMerkleProof(msIndex)
+	if err != nil {
+		if errors.Is(err, database.ErrMilestoneNotFound) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "milestone not found: %d", msIndex)
+		}
+
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "verifying inclusion merkle proof of milestone %d failed, error: %s", msIndex, err)
+	}
+
+	return &milestoneVerifyResponse{
+		Index:                 uint32(verification.Index),
+		InclusionMerkleProof:  hex.EncodeToString(verification.InclusionMerkleProof),
+		ComputedMerkleProof:   hex.EncodeToString(verification.ComputedMerkleProof),
+		IncludedMessagesCount: uint32(len(verification.IncludedMessageIDs)),
+		Valid:                 verification.Valid(),
+	}, nil
+}
+
+// milestoneTimestamp returns the unix timestamp of the milestone with the given index, or 0 if the milestone is unknown.
+func (s *DatabaseServer) milestoneTimestamp(msIndex milestone.Index) (int64, error) {
+	timestamp, err := s.Database.MilestoneTimestampUnixByIndex(msIndex)
+	if err != nil {
+		if errors.Is(err, database.ErrMilestoneNotFound) {
+			return 0, nil
+		}
+
+		return 0, errors.WithMessagef(echo.ErrInternalServerError, "failed to load milestone timestamp for index: %d, error: %s", msIndex, err)
+	}
+
+	return timestamp, nil
+}
+
+// milestoneVerified returns whether the signatures of the milestone payload with the given index are valid
+// with respect to the configured milestone public keys.
+// It returns nil if the milestone payload is not available or no milestone public keys are configured for the index.
+func (s *DatabaseServer) milestoneVerified(msIndex milestone.Index) *bool {
+	ms := s.Database.MilestonePayloadOrNil(msIndex)
+	if ms == nil || milestone.Index(ms.Index) != msIndex {
+		return nil
+	}
+
+	err := s.KeyManager.VerifyMilestone(ms)
+	if errors.Is(err, keymanager.ErrNoPublicKeysForMilestoneIndex) {
+		return nil
+	}
+
+	verified := err == nil
+
+	return &verified
+}
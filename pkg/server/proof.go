@@ -0,0 +1,103 @@
+package server
+
+import (
+	"encoding/hex"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/merkle"
+	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+func (s *DatabaseServer) messageProofByMessageID(messageID hornet.MessageID) (*messageProofResponse, error) {
+	msg := s.Database.MessageOrNil(messageID)
+	if msg == nil {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "message not found: %s", messageID.ToHex())
+	}
+
+	proof, err := s.Database.MessageInclusionProof(messageID)
+	if err != nil {
+		switch {
+		case errors.Is(err, database.ErrMessageNotIncluded):
+			return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "message not included in the ledger: %s", messageID.ToHex())
+		case errors.Is(err, database.ErrMessageNotFound), errors.Is(err, database.ErrMilestoneNotFound):
+			return nil, errors.WithMessagef(echo.ErrNotFound, "creating proof for message %s failed, error: %s", messageID.ToHex(), err)
+		default:
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "creating proof for message %s failed, error: %s", messageID.ToHex(), err)
+		}
+	}
+
+	auditPath := make([]*auditPathNode, len(proof.AuditPath))
+	for i, node := range proof.AuditPath {
+		auditPath[i] = &auditPathNode{
+			Hash: hex.EncodeToString(node.Hash),
+			Left: node.Left,
+		}
+	}
+
+	return &messageProofResponse{
+		Message:   msg.Message(),
+		Milestone: proof.MilestoneMessage.Message(),
+		AuditPath: auditPath,
+	}, nil
+}
+
+func (s *DatabaseServer) validateProof(c echo.Context) (*proofValidateResponse, error) {
+	proof := &messageProofResponse{}
+	if err := c.Bind(proof); err != nil {
+		return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid proof, error: %s", err)
+	}
+
+	if proof.Message == nil || proof.Milestone == nil {
+		return nil, errors.WithMessage(restapi.ErrInvalidParameter, "invalid proof, error: message or milestone missing")
+	}
+
+	ms, ok := proof.Milestone.Payload.(*iotago.Milestone)
+	if !ok {
+		return nil, errors.WithMessage(restapi.ErrInvalidParameter, "invalid proof, error: milestone message does not contain a milestone payload")
+	}
+
+	messageID, err := proof.Message.ID()
+	if err != nil {
+		return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid proof, error: can't compute message ID: %s", err)
+	}
+
+	auditPath := make([]*merkle.AuditPathNode, len(proof.AuditPath))
+	for i, node := range proof.AuditPath {
+		if node == nil {
+			return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid proof, error: audit path node %d missing", i)
+		}
+
+		hash, err := hex.DecodeString(node.Hash)
+		if err != nil {
+			return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid proof, error: can't decode audit path node %d: %s", i, err)
+		}
+
+		auditPath[i] = &merkle.AuditPathNode{
+			Hash: hash,
+			Left: node.Left,
+		}
+	}
+
+	inclusionValid := merkle.NewHasher().VerifyAuditPath(ms.InclusionMerkleProof[:], messageID[:], auditPath)
+
+	signaturesValid := true
+	signaturesError := ""
+	if err := s.KeyManager.VerifyMilestone(ms); err != nil {
+		signaturesValid = false
+		signaturesError = err.Error()
+	}
+
+	return &proofValidateResponse{
+		MessageID:       hex.EncodeToString(messageID[:]),
+		MilestoneIndex:  ms.Index,
+		InclusionValid:  inclusionValid,
+		SignaturesValid: signaturesValid,
+		SignaturesError: signaturesError,
+		Valid:           inclusionValid && signaturesValid,
+	}, nil
+}
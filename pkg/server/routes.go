@@ -30,6 +30,22 @@ const (
 	// GET returns the message IDs of all children.
 	RouteMessageChildren = RouteMessageData + "/children"
 
+	// RouteMessageFull is the route for getting message data, metadata and children of a message, identified by its messageID.
+	// GET returns the message, its metadata (including the timestamp of the referencing milestone) and the message IDs of all children.
+	RouteMessageFull = RouteMessageData + "/full"
+
+	// RouteMessagePastCone is the route for traversing the past cone of a message, identified by its messageID.
+	// GET returns the messages in the past cone, breadth-first (optional query parameters: "depth", "limit").
+	RouteMessagePastCone = RouteMessageData + "/past-cone"
+
+	// RouteMessageFutureCone is the route for traversing the future cone of a message, identified by its messageID.
+	// GET returns the approval path from the message to the milestone that referenced it.
+	RouteMessageFutureCone = RouteMessageData + "/future-cone"
+
+	// RouteMessageProof is the route for getting the proof of inclusion of a message.
+	// GET returns the message, the merkle audit path to the inclusion merkle proof of the milestone and the signed milestone message.
+	RouteMessageProof = RouteMessageData + "/proof"
+
 	// RouteMessages is the route for getting message IDs or creating new messages.
 	// GET with query parameter (mandatory) returns all message IDs that fit these filter criteria (query parameters: "index").
 	// POST creates a single new message and returns the new message ID.
@@ -51,6 +67,15 @@ const (
 	// GET returns the message IDs of all children.
 	RouteTransactionsIncludedMessageChildren = RouteTransactionsIncludedMessageData + "/children"
 
+	// RouteTransactionsValidate is the route for validating a transaction against the stored ledger without booking it.
+	// POST validates a transaction payload (json) or a message containing a transaction payload (json or bytes).
+	RouteTransactionsValidate = "/transactions/validate"
+
+	// RouteTransactionsSignatureAudit is the route for re-verifying the signatures of the stored transactions of a milestone range.
+	// GET returns all transactions included in the range whose signatures don't verify against their input addresses (query parameters: "startIndex", optional: "endIndex").
+	// At most the maximum count of results of milestones are audited, larger ranges are truncated.
+	RouteTransactionsSignatureAudit = "/transactions/signature-audit"
+
 	// RouteMilestone is the route for getting a milestone by it's milestoneIndex.
 	// GET returns the milestone.
 	RouteMilestone = "/milestones/:" + restapipkg.ParameterMilestoneIndex
@@ -59,10 +84,26 @@ const (
 	// GET returns the output IDs of all UTXO changes.
 	RouteMilestoneUTXOChanges = RouteMilestone + "/utxo-changes"
 
+	// RouteMilestoneReferencedMessages is the route for getting all messages referenced by a milestone by its milestoneIndex.
+	// GET returns the message IDs of all referenced messages in white-flag order and their ledger inclusion state.
+	RouteMilestoneReferencedMessages = RouteMilestone + "/referenced-messages"
+
+	// RouteMilestoneVerify is the route for verifying the inclusion merkle proof of a milestone by its milestoneIndex.
+	// GET returns the inclusion merkle proof of the milestone and the recomputed merkle tree hash of the included messages.
+	RouteMilestoneVerify = RouteMilestone + "/verify"
+
 	// RouteOutput is the route for getting outputs by their outputID (transactionHash + outputIndex).
-	// GET returns the output.
+	// GET returns the output (optional query parameters: "at").
 	RouteOutput = "/outputs/:" + restapipkg.ParameterOutputID
 
+	// RouteOutputTrace is the route for tracing the funds of an output forward through the transactions that spent them.
+	// GET returns the spending transactions and their outputs, breadth-first (optional query parameters: "depth").
+	RouteOutputTrace = RouteOutput + "/trace"
+
+	// RouteOutputProvenance is the route for tracing the funds of an output backward through the transactions that created them.
+	// GET returns the graph of the outputs the funds originated from (optional query parameters: "depth").
+	RouteOutputProvenance = RouteOutput + "/provenance"
+
 	// RouteAddressBech32Balance is the route for getting the total balance of all unspent outputs of an address.
 	// The address must be encoded in bech32.
 	// GET returns the balance of all unspent outputs of this address.
@@ -75,14 +116,68 @@ const (
 
 	// RouteAddressBech32Outputs is the route for getting all output IDs for an address.
 	// The address must be encoded in bech32.
-	// GET returns the outputIDs for all outputs of this address (optional query parameters: "include-spent").
+	// GET returns the outputIDs for all outputs of this address (optional query parameters: "include-spent", "type", "at").
+	// "include-spent" and "at" can't be combined.
 	RouteAddressBech32Outputs = "/addresses/:" + restapipkg.ParameterAddress + "/outputs"
 
 	// RouteAddressEd25519Outputs is the route for getting all output IDs for an ed25519 address.
 	// The ed25519 address must be encoded in hex.
-	// GET returns the outputIDs for all outputs of this address (optional query parameters: "include-spent").
+	// GET returns the outputIDs for all outputs of this address (optional query parameters: "include-spent", "type", "at").
+	// "include-spent" and "at" can't be combined.
 	RouteAddressEd25519Outputs = "/addresses/ed25519/:" + restapipkg.ParameterAddress + "/outputs"
 
+	// RouteAddressBech32Summary is the route for getting an activity summary of an address.
+	// The address must be encoded in bech32.
+	// GET returns the first received and last spent milestone, the received and sent totals and the output counts of this address.
+	RouteAddressBech32Summary = "/addresses/:" + restapipkg.ParameterAddress + "/summary"
+
+	// RouteAddressEd25519Summary is the route for getting an activity summary of an ed25519 address.
+	// The ed25519 address must be encoded in hex.
+	// GET returns the first received and last spent milestone, the received and sent totals and the output counts of this address.
+	RouteAddressEd25519Summary = "/addresses/ed25519/:" + restapipkg.ParameterAddress + "/summary"
+
+	// RouteAddressBech32BalanceProof is the route for getting a proof of the balance of an address against the ledger state commitment.
+	// The address must be encoded in bech32.
+	// GET returns the unspent outputs of this address and their merkle audit path to the ledger state commitment.
+	RouteAddressBech32BalanceProof = "/addresses/:" + restapipkg.ParameterAddress + "/balance-proof"
+
+	// RouteAddressEd25519BalanceProof is the route for getting a proof of the balance of an ed25519 address against the ledger state commitment.
+	// The ed25519 address must be encoded in hex.
+	// GET returns the unspent outputs of this address and their merkle audit path to the ledger state commitment.
+	RouteAddressEd25519BalanceProof = "/addresses/ed25519/:" + restapipkg.ParameterAddress + "/balance-proof"
+
+	// RouteAddressBech32ExportCSV is the route for exporting the history of an address as CSV.
+	// The address must be encoded in bech32.
+	// GET returns all credits and debits of this address with the running balance (CSV).
+	RouteAddressBech32ExportCSV = "/addresses/:" + restapipkg.ParameterAddress + "/export.csv"
+
+	// RouteAddressEd25519ExportCSV is the route for exporting the history of an ed25519 address as CSV.
+	// The ed25519 address must be encoded in hex.
+	// GET returns all credits and debits of this address with the running balance (CSV).
+	RouteAddressEd25519ExportCSV = "/addresses/ed25519/:" + restapipkg.ParameterAddress + "/export.csv"
+
+	// RouteAddressBech32Counterparties is the route for getting the addresses an address exchanged funds with.
+	// The address must be encoded in bech32.
+	// GET returns the counterparties of this address with the sent and received totals and transaction counts.
+	RouteAddressBech32Counterparties = "/addresses/:" + restapipkg.ParameterAddress + "/counterparties"
+
+	// RouteAddressEd25519Counterparties is the route for getting the addresses an ed25519 address exchanged funds with.
+	// The ed25519 address must be encoded in hex.
+	// GET returns the counterparties of this address with the sent and received totals and transaction counts.
+	RouteAddressEd25519Counterparties = "/addresses/ed25519/:" + restapipkg.ParameterAddress + "/counterparties"
+
+	// RouteSearch is the route for searching a message, transaction, output, address, milestone or indexation.
+	// GET returns the typed search results with links to the canonical resources.
+	RouteSearch = "/search/:" + restapipkg.ParameterSearchQuery
+
+	// RouteProofValidate is the route for validating a proof of inclusion of a message.
+	// POST validates the given proof against the configured milestone public keys.
+	RouteProofValidate = "/proof/validate"
+
+	// RouteLedgerCommitment is the route for getting the ledger state commitment.
+	// GET returns the deterministic commitment to the unspent outputs and the treasury output at the ledger index.
+	RouteLedgerCommitment = "/ledger/commitment"
+
 	// RouteTreasury is the route for getting the current treasury output.
 	RouteTreasury = "/treasury"
 
@@ -160,6 +255,57 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		return restapipkg.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteMessageFull, func(c echo.Context) error {
+		messageID, err := restapipkg.ParseMessageIDParam(c)
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.messageFullByMessageID(messageID)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteMessagePastCone, func(c echo.Context) error {
+		resp, err := s.pastConeByMessageID(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteMessageFutureCone, func(c echo.Context) error {
+		messageID, err := restapipkg.ParseMessageIDParam(c)
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.futureConeByMessageID(messageID)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteMessageProof, func(c echo.Context) error {
+		messageID, err := restapipkg.ParseMessageIDParam(c)
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.messageProofByMessageID(messageID)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteMessages, func(c echo.Context) error {
 		resp, err := s.messageIDsByIndex(c)
 		if err != nil {
@@ -225,6 +371,24 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		return restapipkg.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.POST(RouteTransactionsValidate, func(c echo.Context) error {
+		resp, err := s.validateTransaction(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteTransactionsSignatureAudit, func(c echo.Context) error {
+		resp, err := s.transactionSignatureAudit(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteMilestone, func(c echo.Context) error {
 		resp, err := s.milestoneByIndex(c)
 		if err != nil {
@@ -243,6 +407,24 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		return restapipkg.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteMilestoneReferencedMessages, func(c echo.Context) error {
+		resp, err := s.milestoneReferencedMessagesByIndex(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteMilestoneVerify, func(c echo.Context) error {
+		resp, err := s.milestoneVerifyByIndex(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteOutput, func(c echo.Context) error {
 		resp, err := s.outputByID(c)
 		if err != nil {
@@ -252,6 +434,24 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		return restapipkg.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteOutputTrace, func(c echo.Context) error {
+		resp, err := s.outputTraceByID(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteOutputProvenance, func(c echo.Context) error {
+		resp, err := s.outputProvenanceByID(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteAddressBech32Balance, func(c echo.Context) error {
 		resp, err := s.balanceByBech32Address(c)
 		if err != nil {
@@ -288,6 +488,95 @@ func (s *DatabaseServer) configureRoutes(routeGroup echoswagger.ApiGroup) {
 		return restapipkg.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteAddressBech32Summary, func(c echo.Context) error {
+		resp, err := s.summaryByBech32Address(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteAddressEd25519Summary, func(c echo.Context) error {
+		resp, err := s.summaryByEd25519Address(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteAddressBech32BalanceProof, func(c echo.Context) error {
+		resp, err := s.balanceProofByBech32Address(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteAddressEd25519BalanceProof, func(c echo.Context) error {
+		resp, err := s.balanceProofByEd25519Address(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteAddressBech32ExportCSV, func(c echo.Context) error {
+		return s.exportCSVByBech32Address(c)
+	})
+
+	routeGroup.GET(RouteAddressEd25519ExportCSV, func(c echo.Context) error {
+		return s.exportCSVByEd25519Address(c)
+	})
+
+	routeGroup.GET(RouteAddressBech32Counterparties, func(c echo.Context) error {
+		resp, err := s.counterpartiesByBech32Address(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteAddressEd25519Counterparties, func(c echo.Context) error {
+		resp, err := s.counterpartiesByEd25519Address(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteSearch, func(c echo.Context) error {
+		resp, err := s.search(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.POST(RouteProofValidate, func(c echo.Context) error {
+		resp, err := s.validateProof(c)
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteLedgerCommitment, func(c echo.Context) error {
+		resp, err := s.ledgerCommitment()
+		if err != nil {
+			return err
+		}
+
+		return restapipkg.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteTreasury, func(c echo.Context) error {
 		resp, err := s.treasury(c)
 		if err != nil {
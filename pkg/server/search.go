@@ -0,0 +1,153 @@
+package server
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/inx-api-core-v1/pkg/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	restapipkg "github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+const (
+	SearchResultTypeMessage     = "message"
+	SearchResultTypeTransaction = "transaction"
+	SearchResultTypeOutput      = "output"
+	SearchResultTypeAddress     = "address"
+	SearchResultTypeMilestone   = "milestone"
+	SearchResultTypeIndexation  = "indexation"
+)
+
+// routeWithParameter replaces the given parameter in the route with the value.
+func routeWithParameter(route string, parameter string, value string) string {
+	return strings.Replace(route, ":"+parameter, value, 1)
+}
+
+func (s *DatabaseServer) search(c echo.Context) (*searchResponse, error) {
+	query := strings.TrimSpace(c.Param(restapipkg.ParameterSearchQuery))
+	if query == "" {
+		return nil, errors.WithMessagef(restapipkg.ErrInvalidParameter, "parameter \"%s\" not specified", restapipkg.ParameterSearchQuery)
+	}
+
+	results := make([]*searchResult, 0)
+	addResult := func(resultType string, link string) {
+		results = append(results, &searchResult{Type: resultType, Link: link})
+	}
+
+	queryLower := strings.ToLower(query)
+
+	if queryBytes, err := hex.DecodeString(queryLower); err == nil {
+		switch len(queryBytes) {
+		case iotago.MessageIDLength:
+			// message IDs, transaction IDs and ed25519 addresses share the same length
+			if s.Database.MessageMetadataOrNil(hornet.MessageIDFromSlice(queryBytes)) != nil {
+				addResult(SearchResultTypeMessage, routeWithParameter(RouteMessageData, restapipkg.ParameterMessageID, queryLower))
+			}
+
+			// every Chrysalis transaction has at least one output, so probing the output with index 0 is sufficient
+			outputID := &iotago.UTXOInputID{}
+			copy(outputID[:], queryBytes)
+			exists, err := s.outputExists(outputID)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				addResult(SearchResultTypeTransaction, routeWithParameter(RouteTransactionsIncludedMessageData, restapipkg.ParameterTransactionID, queryLower))
+			}
+
+			address := &iotago.Ed25519Address{}
+			copy(address[:], queryBytes)
+			known, err := s.addressKnown(address)
+			if err != nil {
+				return nil, err
+			}
+			if known {
+				addResult(SearchResultTypeAddress, routeWithParameter(RouteAddressEd25519Balance, restapipkg.ParameterAddress, queryLower))
+			}
+
+		case utxo.OutputIDLength:
+			outputID := &iotago.UTXOInputID{}
+			copy(outputID[:], queryBytes)
+			exists, err := s.outputExists(outputID)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				addResult(SearchResultTypeOutput, routeWithParameter(RouteOutput, restapipkg.ParameterOutputID, queryLower))
+			}
+		}
+	}
+
+	if hrp, address, err := iotago.ParseBech32(queryLower); err == nil && hrp == s.Bech32HRP {
+		known, err := s.addressKnown(address)
+		if err != nil {
+			return nil, err
+		}
+		if known {
+			addResult(SearchResultTypeAddress, routeWithParameter(RouteAddressBech32Balance, restapipkg.ParameterAddress, address.Bech32(hrp)))
+		}
+	}
+
+	if msIndex, err := strconv.ParseUint(query, 10, 32); err == nil {
+		if s.Database.MilestoneOrNil(milestone.Index(msIndex)) != nil {
+			addResult(SearchResultTypeMilestone, routeWithParameter(RouteMilestone, restapipkg.ParameterMilestoneIndex, query))
+		}
+	}
+
+	if len(query) <= database.IndexationIndexLength {
+		messageIDs, err := s.Database.IndexMessageIDs([]byte(query), 1)
+		if err != nil {
+			return nil, errors.WithMessage(echo.ErrInternalServerError, err.Error())
+		}
+		if len(messageIDs) > 0 {
+			addResult(SearchResultTypeIndexation, RouteMessages+"?index="+hex.EncodeToString([]byte(query)))
+		}
+	}
+
+	if len(results) == 0 {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "no results found for query: %s", query)
+	}
+
+	return &searchResponse{
+		Query:   query,
+		Results: results,
+	}, nil
+}
+
+func (s *DatabaseServer) outputExists(outputID *iotago.UTXOInputID) (bool, error) {
+	if _, err := s.UTXOManager.ReadOutputByOutputID(outputID); err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return false, nil
+		}
+
+		return false, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", outputID.ToHex(), err)
+	}
+
+	return true, nil
+}
+
+// addressKnown returns whether the address ever received an output.
+func (s *DatabaseServer) addressKnown(address iotago.Address) (bool, error) {
+	unspentOutputs, err := s.UTXOManager.UnspentOutputs(utxo.FilterAddress(address), utxo.MaxResultCount(1))
+	if err != nil {
+		return false, errors.WithMessagef(echo.ErrInternalServerError, "reading unspent outputs failed: %s, error: %s", address, err)
+	}
+	if len(unspentOutputs) > 0 {
+		return true, nil
+	}
+
+	spents, err := s.UTXOManager.SpentOutputs(utxo.FilterAddress(address), utxo.MaxResultCount(1))
+	if err != nil {
+		return false, errors.WithMessagef(echo.ErrInternalServerError, "reading spent outputs failed: %s, error: %s", address, err)
+	}
+
+	return len(spents) > 0, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/iotaledger/hive.go/core/app"
 	"github.com/iotaledger/inx-api-core-v1/pkg/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/keymanager"
 	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
 	iotago "github.com/iotaledger/iota.go/v2"
 )
@@ -18,16 +19,18 @@ type DatabaseServer struct {
 	AppInfo                 *app.Info
 	Database                *database.Database
 	UTXOManager             *utxo.Manager
+	KeyManager              *keymanager.KeyManager
 	NetworkIDName           string
 	Bech32HRP               iotago.NetworkPrefix
 	RestAPILimitsMaxResults int
 }
 
-func NewDatabaseServer(swagger echoswagger.ApiRoot, appInfo *app.Info, db *database.Database, utxoManager *utxo.Manager, networkIDName string, bech32HRP iotago.NetworkPrefix, maxResults int) *DatabaseServer {
+func NewDatabaseServer(swagger echoswagger.ApiRoot, appInfo *app.Info, db *database.Database, utxoManager *utxo.Manager, keyManager *keymanager.KeyManager, networkIDName string, bech32HRP iotago.NetworkPrefix, maxResults int) *DatabaseServer {
 	s := &DatabaseServer{
 		AppInfo:                 appInfo,
 		Database:                db,
 		UTXOManager:             utxoManager,
+		KeyManager:              keyManager,
 		NetworkIDName:           networkIDName,
 		Bech32HRP:               bech32HRP,
 		RestAPILimitsMaxResults: maxResults,
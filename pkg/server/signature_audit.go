@@ -0,0 +1,72 @@
+package server
+
+import (
+	"encoding/hex"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+)
+
+func (s *DatabaseServer) transactionSignatureAudit(c echo.Context) (*transactionSignatureAuditResponse, error) {
+
+	startIndex, err := restapi.ParseMilestoneIndexQueryParam(c, restapi.QueryParameterStartIndex)
+	if err != nil {
+		return nil, err
+	}
+	if startIndex == nil {
+		return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "parameter \"%s\" not specified", restapi.QueryParameterStartIndex)
+	}
+
+	endIndex, err := restapi.ParseMilestoneIndexQueryParam(c, restapi.QueryParameterEndIndex)
+	if err != nil {
+		return nil, err
+	}
+	if endIndex == nil {
+		endIndex = startIndex
+	}
+
+	if *endIndex < *startIndex {
+		return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid milestone range: end index %d is smaller than start index %d", *endIndex, *startIndex)
+	}
+
+	// the messages below the snapshot index are not stored
+	snapshotIndex := s.Database.SnapshotInfo().SnapshotIndex
+	ledgerIndex := s.UTXOManager.ReadLedgerIndex()
+	if *startIndex <= snapshotIndex || *endIndex > ledgerIndex {
+		return nil, errors.WithMessagef(echo.ErrNotFound, "milestone range %d-%d not available, available range: %d-%d", *startIndex, *endIndex, snapshotIndex+1, ledgerIndex)
+	}
+
+	// audit at most maxResults milestones, the caller continues after the returned end index
+	effectiveEndIndex := *endIndex
+	truncated := false
+	if int(*endIndex-*startIndex)+1 > s.RestAPILimitsMaxResults {
+		effectiveEndIndex = *startIndex + milestone.Index(s.RestAPILimitsMaxResults) - 1
+		truncated = true
+	}
+
+	result, err := s.Database.AuditTransactionSignatures(*startIndex, effectiveEndIndex)
+	if err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "auditing transaction signatures failed, error: %s", err)
+	}
+
+	invalidTransactions := make([]*invalidTransactionSignatures, 0, len(result.InvalidTransactions))
+	for _, invalidTransaction := range result.InvalidTransactions {
+		invalidTransactions = append(invalidTransactions, &invalidTransactionSignatures{
+			MilestoneIndex: invalidTransaction.MilestoneIndex,
+			MessageID:      invalidTransaction.MessageID.ToHex(),
+			TransactionID:  hex.EncodeToString(invalidTransaction.TransactionID[:]),
+			Errors:         invalidTransaction.Errors,
+		})
+	}
+
+	return &transactionSignatureAuditResponse{
+		StartIndex:          result.StartIndex,
+		EndIndex:            result.EndIndex,
+		Truncated:           truncated,
+		Transactions:        result.Transactions,
+		InvalidTransactions: invalidTransactions,
+	}, nil
+}
@@ -0,0 +1,370 @@
+package server
+
+import (
+	"encoding/hex"
+	"math/bits"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+const (
+	// DefaultTraceDepth is the default depth of a fund tracing traversal.
+	DefaultTraceDepth = 10
+
+	// OutputOriginMigration denotes outputs that were created by a receipt of a milestone.
+	OutputOriginMigration = "migration"
+	// OutputOriginUnknown denotes outputs that are not available or whose creating message is not available.
+	OutputOriginUnknown = "unknown"
+)
+
+// proportionalAmount returns amount * numerator / denominator without overflowing.
+func proportionalAmount(amount uint64, numerator uint64, denominator uint64) uint64 {
+	if denominator == 0 {
+		return 0
+	}
+
+	hi, lo := bits.Mul64(amount, numerator)
+	if hi >= denominator {
+		// the numerator never exceeds the denominator, so this can't happen
+		return amount
+	}
+
+	quo, _ := bits.Div64(hi, lo, denominator)
+
+	return quo
+}
+
+// spentOrNil returns the spent of the output or nil if the output is unspent.
+//
+//nolint:nilnil // nil is returned if the output is unspent
+func (s *DatabaseServer) spentOrNil(output *utxo.Output) (*utxo.Spent, error) {
+	spent, err := s.UTXOManager.ReadSpentForOutput(output)
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, nil
+		}
+
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading spent status failed: %s, error: %s", output.OutputID().ToHex(), err)
+	}
+
+	return spent, nil
+}
+
+// outputTraceItem is a traced output together with the share of the traced funds it holds.
+type outputTraceItem struct {
+	output       *utxo.Output
+	spent        *utxo.Spent
+	tracedAmount uint64
+}
+
+// tracedTransaction is a transaction in a fund trace together with its outputs.
+type tracedTransaction struct {
+	transaction *outputTraceTransaction
+	outputs     []*utxo.Output
+	spents      []*utxo.Spent
+	// the output IDs of the traced inputs, to not list an input twice if it receives additional traced funds.
+	tracedInputs map[string]struct{}
+}
+
+// newTracedTransaction loads the outputs of the transaction with the given ID.
+func (s *DatabaseServer) newTracedTransaction(transactionID iotago.TransactionID, depth uint32, spent *utxo.Spent) (*tracedTransaction, error) {
+	transactionOutputs, err := s.UTXOManager.TransactionOutputs(&transactionID)
+	if err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading outputs of transaction failed: %s, error: %s", hex.EncodeToString(transactionID[:]), err)
+	}
+
+	traced := &tracedTransaction{
+		transaction: &outputTraceTransaction{
+			TransactionID:  hex.EncodeToString(transactionID[:]),
+			Depth:          depth,
+			MilestoneIndex: spent.ConfirmationIndex(),
+			TracedInputIDs: make([]string, 0),
+			Outputs:        make([]*outputTraceOutput, len(transactionOutputs)),
+		},
+		outputs:      transactionOutputs,
+		spents:       make([]*utxo.Spent, len(transactionOutputs)),
+		tracedInputs: make(map[string]struct{}),
+	}
+
+	for i, transactionOutput := range transactionOutputs {
+		// the sum of the inputs of a confirmed transaction always equals the sum of its outputs
+		traced.transaction.InputAmount += transactionOutput.Amount()
+
+		outputSpent, err := s.spentOrNil(transactionOutput)
+		if err != nil {
+			return nil, err
+		}
+		traced.spents[i] = outputSpent
+
+		traceOutput := &outputTraceOutput{
+			OutputID: transactionOutput.OutputID().ToHex(),
+			Address:  transactionOutput.Address().Bech32(s.Bech32HRP),
+			Amount:   transactionOutput.Amount(),
+			Spent:    outputSpent != nil,
+		}
+		if outputSpent != nil {
+			traceOutput.TransactionIDSpent = hex.EncodeToString(outputSpent.TargetTransactionID()[:])
+		}
+		traced.transaction.Outputs[i] = traceOutput
+	}
+
+	return traced, nil
+}
+
+// outputTraceByID follows the funds of an output into the transactions that spent them, breadth-first.
+// The traced funds are distributed to the outputs of a transaction proportionally to their amount.
+// If traced funds reach an already traced transaction again at a later depth (the funds of several branches merge),
+// the funds are added to the transaction, which keeps its depth, and the additional funds are traced further.
+func (s *DatabaseServer) outputTraceByID(c echo.Context) (*outputTraceResponse, error) {
+	outputID, err := restapi.ParseOutputIDParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	depth, err := restapi.ParseUint32QueryParam(c, restapi.QueryParameterDepth, DefaultTraceDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults := s.RestAPILimitsMaxResults
+	ledgerIndex := s.UTXOManager.ReadLedgerIndex()
+
+	output, err := s.UTXOManager.ReadOutputByOutputID(outputID)
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "output not found: %s", outputID.ToHex())
+		}
+
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", outputID.ToHex(), err)
+	}
+
+	spent, err := s.spentOrNil(output)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*outputTraceTransaction, 0)
+	tracedTransactions := make(map[iotago.TransactionID]*tracedTransaction)
+	truncated := false
+
+	currentItems := []*outputTraceItem{{output: output, spent: spent, tracedAmount: output.Amount()}}
+
+	for currentDepth := uint32(1); currentDepth <= depth && len(currentItems) > 0; currentDepth++ {
+
+		// sum up the traced funds that flow into every transaction at this depth
+		var depthTransactionIDs []iotago.TransactionID
+		incomingAmounts := make(map[iotago.TransactionID]uint64)
+
+		for _, item := range currentItems {
+			if item.spent == nil {
+				continue
+			}
+
+			transactionID := *item.spent.TargetTransactionID()
+
+			traced, exists := tracedTransactions[transactionID]
+			if !exists {
+				if len(transactions) >= maxResults {
+					truncated = true
+
+					continue
+				}
+
+				traced, err = s.newTracedTransaction(transactionID, currentDepth, item.spent)
+				if err != nil {
+					return nil, err
+				}
+				tracedTransactions[transactionID] = traced
+				transactions = append(transactions, traced.transaction)
+			}
+
+			if _, exists := incomingAmounts[transactionID]; !exists {
+				depthTransactionIDs = append(depthTransactionIDs, transactionID)
+			}
+			incomingAmounts[transactionID] += item.tracedAmount
+
+			inputID := item.output.OutputID().ToHex()
+			if _, exists := traced.tracedInputs[inputID]; !exists {
+				traced.tracedInputs[inputID] = struct{}{}
+				traced.transaction.TracedInputIDs = append(traced.transaction.TracedInputIDs, inputID)
+			}
+		}
+
+		// distribute the incoming funds to the outputs and trace the additional funds of every output further
+		var nextItems []*outputTraceItem
+		for _, transactionID := range depthTransactionIDs {
+			traced := tracedTransactions[transactionID]
+			traced.transaction.TracedAmount += incomingAmounts[transactionID]
+
+			for i, traceOutput := range traced.transaction.Outputs {
+				tracedAmount := proportionalAmount(traceOutput.Amount, traced.transaction.TracedAmount, traced.transaction.InputAmount)
+				additionalAmount := tracedAmount - traceOutput.TracedAmount
+				traceOutput.TracedAmount = tracedAmount
+
+				if additionalAmount == 0 {
+					continue
+				}
+
+				nextItems = append(nextItems, &outputTraceItem{output: traced.outputs[i], spent: traced.spents[i], tracedAmount: additionalAmount})
+			}
+		}
+
+		currentItems = nextItems
+	}
+
+	return &outputTraceResponse{
+		OutputID:     outputID.ToHex(),
+		Depth:        depth,
+		MaxResults:   uint32(maxResults),
+		Count:        uint32(len(transactions)),
+		Truncated:    truncated,
+		Transactions: transactions,
+		LedgerIndex:  ledgerIndex,
+	}, nil
+}
+
+func (s *DatabaseServer) newOutputGraphNode(output *utxo.Output, depth uint32) *outputGraphNode {
+	milestoneIndexBooked, _ := s.outputMilestoneIndexBooked(output)
+
+	return &outputGraphNode{
+		OutputID:             output.OutputID().ToHex(),
+		Address:              output.Address().Bech32(s.Bech32HRP),
+		Amount:               output.Amount(),
+		Depth:                depth,
+		MilestoneIndexBooked: milestoneIndexBooked,
+	}
+}
+
+// outputProvenanceByID follows the funds of an output backward through the inputs of the transactions that created them, breadth-first.
+func (s *DatabaseServer) outputProvenanceByID(c echo.Context) (*outputProvenanceResponse, error) {
+	outputID, err := restapi.ParseOutputIDParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	depth, err := restapi.ParseUint32QueryParam(c, restapi.QueryParameterDepth, DefaultTraceDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	maxResults := s.RestAPILimitsMaxResults
+	ledgerIndex := s.UTXOManager.ReadLedgerIndex()
+
+	output, err := s.UTXOManager.ReadOutputByOutputID(outputID)
+	if err != nil {
+		if errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "output not found: %s", outputID.ToHex())
+		}
+
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", outputID.ToHex(), err)
+	}
+
+	nodes := []*outputGraphNode{s.newOutputGraphNode(output, 0)}
+	edges := make([]*outputGraphEdge, 0)
+	visitedOutputs := map[iotago.UTXOInputID]struct{}{*outputID: {}}
+	truncated := false
+
+	currentOutputs := []*utxo.Output{output}
+	currentNodes := []*outputGraphNode{nodes[0]}
+
+	for currentDepth := uint32(1); currentDepth <= depth && len(currentOutputs) > 0; currentDepth++ {
+		var nextOutputs []*utxo.Output
+		var nextNodes []*outputGraphNode
+
+		for i, currentOutput := range currentOutputs {
+			msg := s.Database.MessageOrNil(currentOutput.MessageID())
+			if msg == nil {
+				currentNodes[i].Origin = OutputOriginUnknown
+
+				continue
+			}
+
+			essence := msg.TransactionEssence()
+			if essence == nil {
+				if _, isMilestone := msg.Message().Payload.(*iotago.Milestone); isMilestone {
+					currentNodes[i].Origin = OutputOriginMigration
+				} else {
+					currentNodes[i].Origin = OutputOriginUnknown
+				}
+
+				continue
+			}
+
+			transactionID := hex.EncodeToString(currentOutput.OutputID()[:iotago.TransactionIDLength])
+
+			for _, input := range essence.Inputs {
+				utxoInput, ok := input.(*iotago.UTXOInput)
+				if !ok {
+					continue
+				}
+
+				inputID := utxoInput.ID()
+
+				edge := &outputGraphEdge{
+					From:          inputID.ToHex(),
+					To:            currentOutput.OutputID().ToHex(),
+					TransactionID: transactionID,
+				}
+
+				if _, visited := visitedOutputs[inputID]; visited {
+					edges = append(edges, edge)
+
+					continue
+				}
+
+				if len(nodes) >= maxResults {
+					// only add edges between nodes that are part of the result
+					truncated = true
+
+					continue
+				}
+
+				inputOutput, err := s.UTXOManager.ReadOutputByOutputID(&inputID)
+				if err != nil {
+					if !errors.Is(err, kvstore.ErrKeyNotFound) {
+						return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", inputID.ToHex(), err)
+					}
+
+					// the input is not available (e.g. pruned), so its origin can't be traced any further
+					visitedOutputs[inputID] = struct{}{}
+					nodes = append(nodes, &outputGraphNode{
+						OutputID: inputID.ToHex(),
+						Depth:    currentDepth,
+						Origin:   OutputOriginUnknown,
+					})
+					edges = append(edges, edge)
+
+					continue
+				}
+
+				node := s.newOutputGraphNode(inputOutput, currentDepth)
+				visitedOutputs[inputID] = struct{}{}
+				nodes = append(nodes, node)
+				edges = append(edges, edge)
+
+				nextOutputs = append(nextOutputs, inputOutput)
+				nextNodes = append(nextNodes, node)
+			}
+		}
+
+		currentOutputs = nextOutputs
+		currentNodes = nextNodes
+	}
+
+	return &outputProvenanceResponse{
+		OutputID:    outputID.ToHex(),
+		Depth:       depth,
+		MaxResults:  uint32(maxResults),
+		Count:       uint32(len(nodes)),
+		Truncated:   truncated,
+		Nodes:       nodes,
+		Edges:       edges,
+		LedgerIndex: ledgerIndex,
+	}, nil
+}
@@ -0,0 +1,241 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/serializer"
+	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+const (
+	// TransactionValidationCheckSyntax is the check of the syntactic validity of the transaction.
+	TransactionValidationCheckSyntax = "syntax"
+	// TransactionValidationCheckInputs is the check whether the inputs exist and are unspent.
+	TransactionValidationCheckInputs = "inputs"
+	// TransactionValidationCheckAmounts is the check whether the input and output amounts balance.
+	TransactionValidationCheckAmounts = "amounts"
+	// TransactionValidationCheckSignatures is the check of the signature unlock blocks.
+	TransactionValidationCheckSignatures = "signatures"
+	// TransactionValidationCheckDust is the check of the dust allowance rules.
+	TransactionValidationCheckDust = "dust"
+)
+
+// transactionValidationCheck returns the check a validation error of iota.go belongs to.
+// Errors that can't be attributed to a specific check are attributed to the given default check.
+func transactionValidationCheck(err error, defaultCheck string) string {
+	switch {
+	case errors.Is(err, iotago.ErrMissingUTXO),
+		errors.Is(err, iotago.ErrUnknownInputType):
+		return TransactionValidationCheckInputs
+
+	case errors.Is(err, iotago.ErrInputOutputSumMismatch),
+		errors.Is(err, iotago.ErrDepositAmountMustBeGreaterThanZero):
+		return TransactionValidationCheckAmounts
+
+	case errors.Is(err, iotago.ErrInputSignatureUnlockBlockInvalid),
+		errors.Is(err, iotago.ErrSignatureAndAddrIncompatible),
+		errors.Is(err, iotago.ErrUnknownUnlockBlockType),
+		errors.Is(err, iotago.ErrUnknownAddrType),
+		errors.Is(err, iotago.ErrEd25519PubKeyAndAddrMismatch),
+		errors.Is(err, iotago.ErrEd25519SignatureInvalid):
+		return TransactionValidationCheckSignatures
+
+	case errors.Is(err, iotago.ErrInvalidDustAllowance):
+		return TransactionValidationCheckDust
+
+	default:
+		return defaultCheck
+	}
+}
+
+// transactionFromRequest parses a transaction payload or a message containing a transaction payload from the request body.
+// JSON bodies may contain a message or a transaction payload, binary bodies must contain the message bytes.
+func transactionFromRequest(c echo.Context) (*iotago.Transaction, error) {
+	contentType := c.Request().Header.Get(echo.HeaderContentType)
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "reading request body failed, error: %s", err)
+	}
+
+	var payload serializer.Serializable
+
+	switch {
+	case strings.HasPrefix(contentType, echo.MIMEApplicationJSON):
+		fields := make(map[string]json.RawMessage)
+		if err := json.Unmarshal(body, &fields); err != nil {
+			return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid request, error: %s", err)
+		}
+
+		if _, isMessage := fields["payload"]; isMessage {
+			msg := &iotago.Message{}
+			if err := json.Unmarshal(body, msg); err != nil {
+				return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid message, error: %s", err)
+			}
+			payload = msg.Payload
+
+			break
+		}
+
+		tx := &iotago.Transaction{}
+		if err := json.Unmarshal(body, tx); err != nil {
+			return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid transaction payload, error: %s", err)
+		}
+		payload = tx
+
+	case strings.HasPrefix(contentType, echo.MIMEOctetStream):
+		msg := &iotago.Message{}
+		if _, err := msg.Deserialize(body, serializer.DeSeriModePerformValidation); err != nil {
+			return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid message, error: %s", err)
+		}
+		payload = msg.Payload
+
+	default:
+		return nil, errors.WithMessagef(echo.ErrUnsupportedMediaType, "unsupported content type: %s", contentType)
+	}
+
+	tx, ok := payload.(*iotago.Transaction)
+	if !ok {
+		return nil, errors.WithMessage(restapi.ErrInvalidParameter, "invalid request, error: no transaction payload given")
+	}
+
+	return tx, nil
+}
+
+func (s *DatabaseServer) validateTransaction(c echo.Context) (*transactionValidateResponse, error) {
+
+	tx, err := transactionFromRequest(c)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &transactionValidateResponse{
+		LedgerIndex: s.UTXOManager.ReadLedgerIndex(),
+		Errors:      make([]*transactionValidationError, 0),
+	}
+
+	addError := func(check string, format string, args ...interface{}) {
+		response.Errors = append(response.Errors, &transactionValidationError{
+			Check:   check,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if err := tx.SyntacticallyValidate(); err != nil {
+		addError(TransactionValidationCheckSyntax, "%s", err)
+
+		return response, nil
+	}
+
+	transactionID, err := tx.ID()
+	if err != nil {
+		addError(TransactionValidationCheckSyntax, "can't compute transaction ID: %s", err)
+
+		return response, nil
+	}
+	response.TransactionID = hex.EncodeToString(transactionID[:])
+
+	//nolint:forcetypeassert // the essence type is checked by the syntactic validation
+	essence := tx.Essence.(*iotago.TransactionEssence)
+
+	// check that the inputs exist and are unspent
+	inputsFound := true
+	utxos := make(iotago.InputToOutputMapping)
+	for i, input := range essence.Inputs {
+		//nolint:forcetypeassert // the input type is checked by the syntactic validation
+		utxoInputID := input.(*iotago.UTXOInput).ID()
+		outputID := &utxoInputID
+
+		output, err := s.UTXOManager.ReadOutputByOutputID(outputID)
+		if err != nil {
+			if !errors.Is(err, kvstore.ErrKeyNotFound) {
+				return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output %s failed, error: %s", outputID.ToHex(), err)
+			}
+
+			inputsFound = false
+			addError(TransactionValidationCheckInputs, "input %d: output %s not found", i, outputID.ToHex())
+
+			continue
+		}
+
+		unspent, err := s.UTXOManager.IsOutputUnspent(output)
+		if err != nil {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output %s failed, error: %s", outputID.ToHex(), err)
+		}
+
+		if !unspent {
+			spent, err := s.UTXOManager.ReadSpentForOutput(output)
+			if err != nil {
+				addError(TransactionValidationCheckInputs, "input %d: output %s already spent", i, outputID.ToHex())
+			} else {
+				addError(TransactionValidationCheckInputs, "input %d: output %s already spent by transaction %s at milestone %d", i, outputID.ToHex(), hex.EncodeToString(spent.TargetTransactionID()[:]), spent.ConfirmationIndex())
+			}
+		}
+
+		iotagoOut, err := output.TransactionOutput()
+		if err != nil {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output %s failed, error: %s", outputID.ToHex(), err)
+		}
+		utxos[utxoInputID] = iotagoOut
+	}
+
+	outputsSum, err := tx.SemanticallyValidateOutputs(essence)
+	if err != nil {
+		addError(transactionValidationCheck(err, TransactionValidationCheckAmounts), "%s", err)
+	}
+	response.OutputsSum = outputsSum
+
+	if !inputsFound {
+		// the remaining checks need all inputs
+		return response, nil
+	}
+
+	// check that the amounts balance and that the signatures are valid
+	essenceBytes, err := essence.SigningMessage()
+	if err != nil {
+		addError(TransactionValidationCheckSyntax, "can't compute signing message: %s", err)
+
+		return response, nil
+	}
+
+	inputsSum, sigValidFuncs, err := tx.SemanticallyValidateInputs(utxos, essence, essenceBytes)
+	if err != nil {
+		// the inputs are validated before the signature unlock blocks
+		addError(transactionValidationCheck(err, TransactionValidationCheckInputs), "%s", err)
+	} else {
+		response.InputsSum = inputsSum
+
+		if inputsSum != outputsSum {
+			addError(TransactionValidationCheckAmounts, "inputs sum %d does not match outputs sum %d", inputsSum, outputsSum)
+		}
+
+		for _, sigValidFunc := range sigValidFuncs {
+			if err := sigValidFunc(); err != nil {
+				addError(transactionValidationCheck(err, TransactionValidationCheckSignatures), "%s", err)
+			}
+		}
+	}
+
+	// check the dust allowance rules against the stored balances
+	dustValidation := iotago.NewDustSemanticValidation(iotago.DustAllowanceDivisor, iotago.MaxDustOutputsOnAddress, func(address iotago.Address) (uint64, int64, error) {
+		dustAllowanceBalance, dustOutputCount, _, err := s.UTXOManager.AddressDustAllowance(address)
+
+		return dustAllowanceBalance, dustOutputCount, err
+	})
+	if err := dustValidation(tx, utxos); err != nil {
+		addError(transactionValidationCheck(err, TransactionValidationCheckDust), "%s", err)
+	}
+
+	response.Valid = len(response.Errors) == 0
+
+	return response, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/iotaledger/hive.go/core/kvstore"
 	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
 	"github.com/iotaledger/inx-api-core-v1/pkg/restapi"
+	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
 	iotago "github.com/iotaledger/iota.go/v2"
 )
 
@@ -33,3 +34,31 @@ func (s *DatabaseServer) messageIDByTransactionID(c echo.Context) (hornet.Messag
 
 	return output.MessageID(), nil
 }
+
+// transactionInputOutputs returns the outputs consumed by the inputs of the transaction essence.
+// Inputs whose outputs are not available (e.g. pruned) are skipped.
+func (s *DatabaseServer) transactionInputOutputs(essence *iotago.TransactionEssence) (utxo.Outputs, error) {
+	inputOutputs := make(utxo.Outputs, 0, len(essence.Inputs))
+
+	for _, input := range essence.Inputs {
+		utxoInput, ok := input.(*iotago.UTXOInput)
+		if !ok {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "unsupported input type: %T", input)
+		}
+
+		inputID := utxoInput.ID()
+
+		output, err := s.UTXOManager.ReadOutputByOutputID(&inputID)
+		if err != nil {
+			if errors.Is(err, kvstore.ErrKeyNotFound) {
+				continue
+			}
+
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", inputID.ToHex(), err)
+		}
+
+		inputOutputs = append(inputOutputs, output)
+	}
+
+	return inputOutputs, nil
+}
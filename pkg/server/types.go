@@ -3,9 +3,11 @@ package server
 import (
 	"encoding/json"
 
+	"github.com/iotaledger/inx-api-core-v1/pkg/commitment"
 	"github.com/iotaledger/inx-api-core-v1/pkg/database"
 	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
 	"github.com/iotaledger/inx-api-core-v1/pkg/utxo"
+	iotago "github.com/iotaledger/iota.go/v2"
 )
 
 // infoResponse defines the response of a GET info REST API call.
@@ -56,6 +58,8 @@ type messageMetadataResponse struct {
 	Solid bool `json:"isSolid"`
 	// The milestone index that references this message.
 	ReferencedByMilestoneIndex *milestone.Index `json:"referencedByMilestoneIndex,omitempty"`
+	// The unix time of the milestone that references this message.
+	ReferencedByMilestoneTimestamp *int64 `json:"referencedByMilestoneTimestamp,omitempty"`
 	// If this message represents a milestone this is the milestone index
 	MilestoneIndex *milestone.Index `json:"milestoneIndex,omitempty"`
 	// The ledger inclusion state of the transaction payload.
@@ -80,6 +84,58 @@ type childrenResponse struct {
 	Children []string `json:"childrenMessageIds"`
 }
 
+// messageFullResponse defines the response of a GET full message REST API call.
+type messageFullResponse struct {
+	// The message.
+	Message *iotago.Message `json:"message"`
+	// The metadata of the message.
+	Metadata *messageMetadataResponse `json:"metadata"`
+	// The children of the message.
+	Children *childrenResponse `json:"children"`
+}
+
+// messagePastConeResponse defines the response of a GET message past cone REST API call.
+type messagePastConeResponse struct {
+	// The hex encoded message ID of the message.
+	MessageID string `json:"messageId"`
+	// The maximum depth of the traversal.
+	Depth uint32 `json:"depth"`
+	// The maximum count of results that are returned by the node.
+	MaxResults uint32 `json:"maxResults"`
+	// The actual count of results that are returned.
+	Count uint32 `json:"count"`
+	// The messages in the past cone of the message, breadth-first.
+	Messages []*messageConeEntry `json:"messages"`
+}
+
+// messageConeEntry defines a message visited during a cone traversal.
+type messageConeEntry struct {
+	// The hex encoded message ID of the message.
+	MessageID string `json:"messageId"`
+	// The amount of hops from the start message.
+	Depth uint32 `json:"depth"`
+	// The milestone index that references this message.
+	ReferencedByMilestoneIndex *milestone.Index `json:"referencedByMilestoneIndex,omitempty"`
+	// Whether the message is a solid entry point.
+	SolidEntryPoint bool `json:"isSolidEntryPoint,omitempty"`
+	// Whether the message is not available anymore because it was pruned.
+	Pruned bool `json:"isPruned,omitempty"`
+}
+
+// messageFutureConeResponse defines the response of a GET message future cone REST API call.
+type messageFutureConeResponse struct {
+	// The hex encoded message ID of the message.
+	MessageID string `json:"messageId"`
+	// The milestone index that references this message.
+	ReferencedByMilestoneIndex milestone.Index `json:"referencedByMilestoneIndex"`
+	// The hex encoded message ID of the milestone that references this message.
+	MilestoneMessageID string `json:"milestoneMessageId"`
+	// The amount of hops from the message to the milestone.
+	Hops uint32 `json:"hops"`
+	// The hex encoded message IDs of the shortest approval path from the message to the milestone.
+	Path []string `json:"path"`
+}
+
 // messageIDsByIndexResponse defines the response of a GET messages REST API call.
 type messageIDsByIndexResponse struct {
 	// The index of the messages.
@@ -100,6 +156,9 @@ type milestoneResponse struct {
 	MessageID string `json:"messageId"`
 	// The unix time of the milestone payload.
 	Time int64 `json:"timestamp"`
+	// Whether the signatures of the milestone payload are valid with respect to the configured milestone public keys.
+	// Omitted if the milestone payload is not available or no milestone public keys are configured for the milestone index.
+	Verified *bool `json:"verified,omitempty"`
 }
 
 // milestoneUTXOChangesResponse defines the response of a GET milestone UTXO changes REST API call.
@@ -112,6 +171,77 @@ type milestoneUTXOChangesResponse struct {
 	ConsumedOutputs []string `json:"consumedOutputs"`
 }
 
+// milestoneReferencedMessagesResponse defines the response of a GET milestone referenced messages REST API call.
+type milestoneReferencedMessagesResponse struct {
+	// The index of the milestone.
+	Index uint32 `json:"index"`
+	// The maximum count of results that are returned by the node.
+	MaxResults uint32 `json:"maxResults"`
+	// The actual count of results that are returned.
+	Count uint32 `json:"count"`
+	// The messages referenced by the milestone in white-flag order.
+	Messages []*milestoneReferencedMessage `json:"messages"`
+}
+
+// milestoneReferencedMessage defines a message in the response of a GET milestone referenced messages REST API call.
+type milestoneReferencedMessage struct {
+	// The hex encoded message ID of the message.
+	MessageID string `json:"messageId"`
+	// The ledger inclusion state of the transaction payload.
+	LedgerInclusionState string `json:"ledgerInclusionState"`
+	// The reason why this message is marked as conflicting.
+	ConflictReason *database.Conflict `json:"conflictReason,omitempty"`
+}
+
+// milestoneVerifyResponse defines the response of a GET milestone verify REST API call.
+type milestoneVerifyResponse struct {
+	// The index of the milestone.
+	Index uint32 `json:"index"`
+	// The hex encoded inclusion merkle proof contained in the milestone payload.
+	InclusionMerkleProof string `json:"inclusionMerkleProof"`
+	// The hex encoded merkle tree hash recomputed from the messages with included transactions.
+	ComputedMerkleProof string `json:"computedMerkleProof"`
+	// The count of messages with included transactions referenced by the milestone.
+	IncludedMessagesCount uint32 `json:"includedMessagesCount"`
+	// Whether the recomputed merkle tree hash matches the inclusion merkle proof.
+	Valid bool `json:"valid"`
+}
+
+// messageProofResponse defines the response of a GET message proof REST API call.
+// It is also used as the request of a POST proof validate REST API call.
+type messageProofResponse struct {
+	// The message that was included in the ledger.
+	Message *iotago.Message `json:"message"`
+	// The message that contains the milestone which included the message.
+	Milestone *iotago.Message `json:"milestone"`
+	// The merkle audit path from the message ID to the inclusion merkle proof of the milestone, starting at the message ID.
+	AuditPath []*auditPathNode `json:"auditPath"`
+}
+
+// auditPathNode defines a node of the merkle audit path in a message proof.
+type auditPathNode struct {
+	// The hex encoded hash of the sibling subtree.
+	Hash string `json:"hash"`
+	// Whether the sibling subtree is the left child of its parent.
+	Left bool `json:"left"`
+}
+
+// proofValidateResponse defines the response of a POST proof validate REST API call.
+type proofValidateResponse struct {
+	// The hex encoded message ID of the proven message.
+	MessageID string `json:"messageId"`
+	// The index of the milestone that included the message.
+	MilestoneIndex uint32 `json:"milestoneIndex"`
+	// Whether the audit path leads to the inclusion merkle proof of the milestone.
+	InclusionValid bool `json:"inclusionValid"`
+	// Whether the milestone signatures are valid with respect to the configured milestone public keys.
+	SignaturesValid bool `json:"signaturesValid"`
+	// The reason why the milestone signatures are invalid.
+	SignaturesError string `json:"signaturesError,omitempty"`
+	// Whether the proof is valid.
+	Valid bool `json:"valid"`
+}
+
 // OutputResponse defines the response of a GET outputs REST API call.
 type OutputResponse struct {
 	// The hex encoded message ID of the message.
@@ -122,14 +252,130 @@ type OutputResponse struct {
 	OutputIndex uint16 `json:"outputIndex"`
 	// Whether this output is spent.
 	Spent bool `json:"isSpent"`
+	// The milestone index at which this output was created.
+	MilestoneIndexBooked milestone.Index `json:"milestoneIndexBooked,omitempty"`
+	// The unix time of the milestone that created this output.
+	MilestoneTimestampBooked int64 `json:"milestoneTimestampBooked,omitempty"`
 	// The milestone index at which this output was spent.
 	MilestoneIndexSpent milestone.Index `json:"milestoneIndexSpent,omitempty"`
+	// The unix time of the milestone at which this output was spent.
+	MilestoneTimestampSpent int64 `json:"milestoneTimestampSpent,omitempty"`
 	// The transaction this output was spent with.
 	TransactionIDSpent string `json:"transactionIdSpent,omitempty"`
 	// The ledger index at which this output was available at.
 	LedgerIndex milestone.Index `json:"ledgerIndex"`
 	// The output in its serialized form.
 	RawOutput *json.RawMessage `json:"output"`
+	// The state of the output at the requested milestone index (optional).
+	StateAt *outputStateResponse `json:"stateAt,omitempty"`
+}
+
+// outputStateResponse defines the state of an output at a given milestone index.
+type outputStateResponse struct {
+	// The milestone index at which the state of the output was queried.
+	MilestoneIndex milestone.Index `json:"milestoneIndex"`
+	// Whether the output was already created at that milestone index.
+	Existed bool `json:"existed"`
+	// Whether the output was unspent at that milestone index.
+	Unspent bool `json:"isUnspent"`
+}
+
+// outputTraceResponse defines the response of a GET output trace REST API call.
+type outputTraceResponse struct {
+	// The output ID (transaction hash + output index) of the traced output.
+	OutputID string `json:"outputId"`
+	// The maximum depth of the trace.
+	Depth uint32 `json:"depth"`
+	// The maximum count of results that are returned by the node.
+	MaxResults uint32 `json:"maxResults"`
+	// The actual count of results that are returned.
+	Count uint32 `json:"count"`
+	// Whether the trace was cut off because the maximum count of results was reached.
+	Truncated bool `json:"truncated"`
+	// The transactions the traced funds flowed into, breadth-first.
+	Transactions []*outputTraceTransaction `json:"transactions"`
+	// The ledger index at which the trace was calculated.
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// outputTraceTransaction defines a transaction in the response of a GET output trace REST API call.
+type outputTraceTransaction struct {
+	// The hex encoded ID of the transaction.
+	TransactionID string `json:"transactionId"`
+	// The amount of hops from the traced output.
+	Depth uint32 `json:"depth"`
+	// The milestone index at which the transaction was confirmed.
+	MilestoneIndex milestone.Index `json:"milestoneIndex"`
+	// The output IDs (transaction hash + output index) of the traced outputs consumed by the transaction.
+	TracedInputIDs []string `json:"tracedInputIds"`
+	// The amount of the traced funds that flowed into the transaction.
+	TracedAmount uint64 `json:"tracedAmount"`
+	// The sum of all inputs of the transaction.
+	InputAmount uint64 `json:"inputAmount"`
+	// The outputs created by the transaction.
+	Outputs []*outputTraceOutput `json:"outputs"`
+}
+
+// outputTraceOutput defines an output in the response of a GET output trace REST API call.
+type outputTraceOutput struct {
+	// The output ID (transaction hash + output index) of the output.
+	OutputID string `json:"outputId"`
+	// The bech32 encoded address of the output.
+	Address string `json:"address"`
+	// The amount of the output.
+	Amount uint64 `json:"amount"`
+	// The share of the traced funds that flowed into this output, proportional to its amount.
+	TracedAmount uint64 `json:"tracedAmount"`
+	// Whether this output is spent.
+	Spent bool `json:"isSpent"`
+	// The transaction this output was spent with.
+	TransactionIDSpent string `json:"transactionIdSpent,omitempty"`
+}
+
+// outputProvenanceResponse defines the response of a GET output provenance REST API call.
+type outputProvenanceResponse struct {
+	// The output ID (transaction hash + output index) of the traced output.
+	OutputID string `json:"outputId"`
+	// The maximum depth of the trace.
+	Depth uint32 `json:"depth"`
+	// The maximum count of results that are returned by the node.
+	MaxResults uint32 `json:"maxResults"`
+	// The actual count of results that are returned.
+	Count uint32 `json:"count"`
+	// Whether the trace was cut off because the maximum count of results was reached.
+	Truncated bool `json:"truncated"`
+	// The outputs the funds of the traced output originated from.
+	Nodes []*outputGraphNode `json:"nodes"`
+	// The transactions that moved the funds from one output to another.
+	Edges []*outputGraphEdge `json:"edges"`
+	// The ledger index at which the trace was calculated.
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// outputGraphNode defines an output in a graph of outputs.
+type outputGraphNode struct {
+	// The output ID (transaction hash + output index) of the output.
+	OutputID string `json:"outputId"`
+	// The bech32 encoded address of the output (omitted if the output is not available).
+	Address string `json:"address,omitempty"`
+	// The amount of the output (omitted if the output is not available).
+	Amount uint64 `json:"amount,omitempty"`
+	// The amount of hops from the traced output.
+	Depth uint32 `json:"depth"`
+	// The milestone index at which this output was created.
+	MilestoneIndexBooked milestone.Index `json:"milestoneIndexBooked,omitempty"`
+	// The origin of the output if it was not created by a transaction or is not available ("migration", "unknown").
+	Origin string `json:"origin,omitempty"`
+}
+
+// outputGraphEdge defines a transfer of funds between two outputs in a graph of outputs.
+type outputGraphEdge struct {
+	// The output ID (transaction hash + output index) of the consumed output.
+	From string `json:"from"`
+	// The output ID (transaction hash + output index) of the created output.
+	To string `json:"to"`
+	// The hex encoded ID of the transaction that consumed the output.
+	TransactionID string `json:"transactionId"`
 }
 
 // addressBalanceResponse defines the response of a GET addresses REST API call.
@@ -142,6 +388,18 @@ type addressBalanceResponse struct {
 	Balance uint64 `json:"balance"`
 	// Indicates if dust is allowed on this address.
 	DustAllowed bool `json:"dustAllowed"`
+	// The sum of all dust allowance outputs on this address.
+	DustAllowanceBalance uint64 `json:"dustAllowanceBalance"`
+	// The current amount of dust outputs on this address.
+	DustOutputCount int64 `json:"dustOutputCount"`
+	// The amount of dust outputs that can still be created on this address.
+	RemainingDustOutputs int64 `json:"remainingDustOutputs"`
+	// The maximum count of dust allowance output IDs that are returned by the node.
+	DustAllowanceOutputsMaxResults uint32 `json:"dustAllowanceOutputsMaxResults"`
+	// The actual count of dust allowance output IDs that are returned.
+	DustAllowanceOutputsCount uint32 `json:"dustAllowanceOutputsCount"`
+	// The output IDs (transaction hash + output index) of the dust allowance outputs on this address.
+	DustAllowanceOutputIDs []string `json:"dustAllowanceOutputIds"`
 	// The ledger index at which this balance was queried at.
 	LedgerIndex milestone.Index `json:"ledgerIndex"`
 }
@@ -162,6 +420,163 @@ type addressOutputsResponse struct {
 	LedgerIndex milestone.Index `json:"ledgerIndex"`
 }
 
+// addressCounterpartiesResponse defines the response of a GET address counterparties REST API call.
+type addressCounterpartiesResponse struct {
+	// The type of the address (0=Ed25519).
+	AddressType byte `json:"addressType"`
+	// The hex encoded address.
+	Address string `json:"address"`
+	// The maximum count of results that are returned by the node.
+	MaxResults uint32 `json:"maxResults"`
+	// The actual count of results that are returned.
+	Count uint32 `json:"count"`
+	// Whether the result is incomplete because the maximum count of results was reached,
+	// either for the examined transactions of the address or for the returned counterparties.
+	Truncated bool `json:"truncated"`
+	// The addresses this address exchanged funds with, ordered by the exchanged volume.
+	Counterparties []*addressCounterparty `json:"counterparties"`
+	// The ledger index at which the counterparties were calculated.
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// addressCounterparty defines a counterparty in the response of a GET address counterparties REST API call.
+type addressCounterparty struct {
+	// The bech32 encoded address of the counterparty.
+	Address string `json:"address"`
+	// The amount the address sent to the counterparty, proportional to the share of the address in the inputs.
+	SentAmount uint64 `json:"sentAmount"`
+	// The amount of transactions in which the address sent funds to the counterparty.
+	SentCount uint32 `json:"sentCount"`
+	// The amount the address received from the counterparty.
+	ReceivedAmount uint64 `json:"receivedAmount"`
+	// The amount of transactions in which the address received funds from the counterparty.
+	ReceivedCount uint32 `json:"receivedCount"`
+}
+
+// searchResult defines a single result of a GET search REST API call.
+type searchResult struct {
+	// The type of the found resource (message, transaction, output, address, milestone, indexation).
+	Type string `json:"type"`
+	// The link to the canonical resource.
+	Link string `json:"link"`
+}
+
+// searchResponse defines the response of a GET search REST API call.
+type searchResponse struct {
+	// The search query.
+	Query string `json:"query"`
+	// The resources that match the search query.
+	Results []*searchResult `json:"results"`
+}
+
+// addressSummaryResponse defines the response of a GET address summary REST API call.
+type addressSummaryResponse struct {
+	// The type of the address (0=Ed25519).
+	AddressType byte `json:"addressType"`
+	// The hex encoded address.
+	Address string `json:"address"`
+	// The milestone index at which the address received funds for the first time after the snapshot index.
+	// It is omitted if the address didn't receive funds after the snapshot index.
+	FirstReceivedMilestoneIndex *milestone.Index `json:"firstReceivedMilestoneIndex,omitempty"`
+	// The milestone index at which the address spent funds for the last time.
+	// It is omitted if the address never spent funds.
+	LastSpentMilestoneIndex *milestone.Index `json:"lastSpentMilestoneIndex,omitempty"`
+	// The sum of all outputs the address received.
+	TotalReceived uint64 `json:"totalReceived"`
+	// The sum of all outputs the address spent.
+	TotalSent uint64 `json:"totalSent"`
+	// The amount of outputs the address received.
+	OutputCount uint32 `json:"outputCount"`
+	// The amount of outputs the address spent.
+	SpentCount uint32 `json:"spentCount"`
+	// The amount of received outputs that were already part of the snapshot, the milestone they were received at is unknown.
+	SnapshotOutputCount uint32 `json:"snapshotOutputCount"`
+	// The index of the snapshot the ledger was started from.
+	SnapshotIndex milestone.Index `json:"snapshotIndex"`
+	// The ledger index at which this summary was calculated.
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+}
+
+// ledgerCommitmentResponse defines the response of a GET ledger commitment REST API call.
+type ledgerCommitmentResponse struct {
+	// The ledger index of the committed ledger state.
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+	// The hex encoded ledger state commitment.
+	Commitment string `json:"commitment"`
+	// The amount of addresses with unspent outputs.
+	Addresses uint32 `json:"addresses"`
+	// The amount of unspent outputs.
+	UnspentOutputs uint32 `json:"unspentOutputs"`
+	// The committed unspent treasury output.
+	TreasuryOutput *treasuryResponse `json:"treasuryOutput"`
+}
+
+// addressBalanceProofResponse defines the response of a GET address balance proof REST API call.
+type addressBalanceProofResponse struct {
+	// The type of the address (0=Ed25519).
+	AddressType byte `json:"addressType"`
+	// The hex encoded address.
+	Address string `json:"address"`
+	// The balance of the address.
+	Balance uint64 `json:"balance"`
+	// The ledger index of the committed ledger state.
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+	// The hex encoded ledger state commitment the proof leads to.
+	Commitment string `json:"commitment"`
+	// The proof of the unspent outputs of the address, it can be verified with commitment.VerifyBalanceProof.
+	Proof *commitment.BalanceProof `json:"proof"`
+}
+
+// transactionValidateResponse defines the response of a POST transaction validate REST API call.
+type transactionValidateResponse struct {
+	// The hex encoded ID of the validated transaction.
+	TransactionID string `json:"transactionId,omitempty"`
+	// The ledger index the transaction was validated against.
+	LedgerIndex milestone.Index `json:"ledgerIndex"`
+	// The sum of the amounts of the inputs.
+	InputsSum uint64 `json:"inputsSum"`
+	// The sum of the amounts of the outputs.
+	OutputsSum uint64 `json:"outputsSum"`
+	// Whether the transaction is valid with respect to the stored ledger.
+	Valid bool `json:"valid"`
+	// The errors found during the validation.
+	Errors []*transactionValidationError `json:"errors"`
+}
+
+// transactionValidationError defines an error in the response of a POST transaction validate REST API call.
+type transactionValidationError struct {
+	// The check that failed ("syntax", "inputs", "amounts", "signatures" or "dust").
+	Check string `json:"check"`
+	// The description of the error.
+	Message string `json:"message"`
+}
+
+// transactionSignatureAuditResponse defines the response of a GET transaction signature audit REST API call.
+type transactionSignatureAuditResponse struct {
+	// The first milestone index of the audited range.
+	StartIndex milestone.Index `json:"startIndex"`
+	// The last milestone index of the audited range.
+	EndIndex milestone.Index `json:"endIndex"`
+	// Whether the requested range was cut off at the maximum count of milestones, the remaining range starts after the end index.
+	Truncated bool `json:"truncated"`
+	// The amount of audited transactions.
+	Transactions int `json:"transactions"`
+	// The transactions whose signatures don't verify.
+	InvalidTransactions []*invalidTransactionSignatures `json:"invalidTransactions"`
+}
+
+// invalidTransactionSignatures defines a transaction in the response of a GET transaction signature audit REST API call.
+type invalidTransactionSignatures struct {
+	// The index of the milestone that included the transaction.
+	MilestoneIndex milestone.Index `json:"milestoneIndex"`
+	// The hex encoded ID of the message that contains the transaction.
+	MessageID string `json:"messageId"`
+	// The hex encoded ID of the transaction.
+	TransactionID string `json:"transactionId"`
+	// The reasons why the signatures don't verify.
+	Errors []string `json:"errors"`
+}
+
 // treasuryResponse defines the response of a GET treasury REST API call.
 type treasuryResponse struct {
 	MilestoneID string `json:"milestoneId"`
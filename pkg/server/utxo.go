@@ -18,21 +18,10 @@ import (
 	iotago "github.com/iotaledger/iota.go/v2"
 )
 
-func newOutputResponse(output *utxo.Output, ledgerIndex milestone.Index) (*OutputResponse, error) {
-	var rawOutput iotago.Output
-	switch output.OutputType() {
-	case iotago.OutputSigLockedSingleOutput:
-		rawOutput = &iotago.SigLockedSingleOutput{
-			Address: output.Address(),
-			Amount:  output.Amount(),
-		}
-	case iotago.OutputSigLockedDustAllowanceOutput:
-		rawOutput = &iotago.SigLockedDustAllowanceOutput{
-			Address: output.Address(),
-			Amount:  output.Amount(),
-		}
-	default:
-		return nil, errors.WithMessagef(echo.ErrInternalServerError, "unsupported output type: %d", output.OutputType())
+func (s *DatabaseServer) newOutputResponse(output *utxo.Output, ledgerIndex milestone.Index) (*OutputResponse, error) {
+	rawOutput, err := output.TransactionOutput()
+	if err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", output.OutputID().ToHex(), err)
 	}
 
 	rawOutputJSON, err := rawOutput.MarshalJSON()
@@ -42,34 +31,109 @@ func newOutputResponse(output *utxo.Output, ledgerIndex milestone.Index) (*Outpu
 
 	rawRawOutputJSON := json.RawMessage(rawOutputJSON)
 
+	milestoneIndexBooked, booked := s.outputMilestoneIndexBooked(output)
+
+	var milestoneTimestampBooked int64
+	if booked {
+		milestoneTimestampBooked, err = s.milestoneTimestamp(milestoneIndexBooked)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &OutputResponse{
-		MessageID:     output.MessageID().ToHex(),
-		TransactionID: hex.EncodeToString(output.OutputID()[:iotago.TransactionIDLength]),
-		Spent:         false,
-		OutputIndex:   binary.LittleEndian.Uint16(output.OutputID()[iotago.TransactionIDLength : iotago.TransactionIDLength+serializer.UInt16ByteSize]),
-		RawOutput:     &rawRawOutputJSON,
-		LedgerIndex:   ledgerIndex,
+		MessageID:                output.MessageID().ToHex(),
+		TransactionID:            hex.EncodeToString(output.OutputID()[:iotago.TransactionIDLength]),
+		Spent:                    false,
+		OutputIndex:              binary.LittleEndian.Uint16(output.OutputID()[iotago.TransactionIDLength : iotago.TransactionIDLength+serializer.UInt16ByteSize]),
+		MilestoneIndexBooked:     milestoneIndexBooked,
+		MilestoneTimestampBooked: milestoneTimestampBooked,
+		RawOutput:                &rawRawOutputJSON,
+		LedgerIndex:              ledgerIndex,
 	}, nil
 }
 
-func newSpentResponse(spent *utxo.Spent, ledgerIndex milestone.Index) (*OutputResponse, error) {
-	response, err := newOutputResponse(spent.Output(), ledgerIndex)
+func (s *DatabaseServer) newSpentResponse(spent *utxo.Spent, ledgerIndex milestone.Index) (*OutputResponse, error) {
+	response, err := s.newOutputResponse(spent.Output(), ledgerIndex)
 	if err != nil {
 		return nil, err
 	}
 	response.Spent = true
 	response.MilestoneIndexSpent = spent.ConfirmationIndex()
+	response.MilestoneTimestampSpent, err = s.milestoneTimestamp(spent.ConfirmationIndex())
+	if err != nil {
+		return nil, err
+	}
 	response.TransactionIDSpent = hex.EncodeToString(spent.TargetTransactionID()[:])
 
 	return response, nil
 }
 
+// outputMilestoneIndexBooked returns the index of the milestone that referenced the message which created the output.
+func (s *DatabaseServer) outputMilestoneIndexBooked(output *utxo.Output) (milestone.Index, bool) {
+	msgMeta := s.Database.MessageMetadataOrNil(output.MessageID())
+	if msgMeta == nil {
+		return 0, false
+	}
+
+	referenced, referencedIndex := msgMeta.ReferencedWithIndex()
+	if !referenced {
+		return 0, false
+	}
+
+	return referencedIndex, true
+}
+
+// outputStateAt returns whether the output was already created and whether it was still unspent at the given milestone index.
+// The spent is nil if the output is unspent.
+// Outputs without a known creating milestone were part of the snapshot the ledger was started from.
+func (s *DatabaseServer) outputStateAt(output *utxo.Output, spent *utxo.Spent, msIndex milestone.Index) (bool, bool) {
+	if milestoneIndexBooked, booked := s.outputMilestoneIndexBooked(output); booked && milestoneIndexBooked > msIndex {
+		return false, false
+	}
+
+	if spent != nil && spent.ConfirmationIndex() <= msIndex {
+		return true, false
+	}
+
+	return true, true
+}
+
+// parseLedgerIndexAtQueryParam parses the optional "at" query parameter and checks that
+// the milestone index lies within the range of the ledger state known by the database.
+//
+//nolint:nilnil // nil is returned if the query parameter is not specified
+func (s *DatabaseServer) parseLedgerIndexAtQueryParam(c echo.Context) (*milestone.Index, error) {
+	msIndex, err := restapi.ParseMilestoneIndexQueryParam(c, restapi.QueryParameterAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if msIndex == nil {
+		return nil, nil
+	}
+
+	snapshotIndex := s.Database.SnapshotInfo().SnapshotIndex
+	ledgerIndex := s.UTXOManager.ReadLedgerIndex()
+
+	if *msIndex < snapshotIndex || *msIndex > ledgerIndex {
+		return nil, errors.WithMessagef(restapi.ErrInvalidParameter, "invalid milestone index: %d, must be between %d and %d", *msIndex, snapshotIndex, ledgerIndex)
+	}
+
+	return msIndex, nil
+}
+
 func (s *DatabaseServer) outputByID(c echo.Context) (*OutputResponse, error) {
 	outputID, err := restapi.ParseOutputIDParam(c)
 	if err != nil {
 		return nil, err
 	}
 
+	atIndex, err := s.parseLedgerIndexAtQueryParam(c)
+	if err != nil {
+		return nil, err
+	}
+
 	ledgerIndex := s.UTXOManager.ReadLedgerIndex()
 
 	output, err := s.UTXOManager.ReadOutputByOutputID(outputID)
@@ -86,35 +150,77 @@ func (s *DatabaseServer) outputByID(c echo.Context) (*OutputResponse, error) {
 		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading spent status failed: %s, error: %s", outputID.ToHex(), err)
 	}
 
+	var spent *utxo.Spent
+	var response *OutputResponse
+
 	if isUnspent {
-		return newOutputResponse(output, ledgerIndex)
-	}
+		response, err = s.newOutputResponse(output, ledgerIndex)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		spent, err = s.UTXOManager.ReadSpentForOutput(output)
+		if err != nil {
+			if errors.Is(err, kvstore.ErrKeyNotFound) {
+				return nil, errors.WithMessagef(echo.ErrNotFound, "output not found: %s", outputID.ToHex())
+			}
 
-	spent, err := s.UTXOManager.ReadSpentForOutput(output)
-	if err != nil {
-		if errors.Is(err, kvstore.ErrKeyNotFound) {
-			return nil, errors.WithMessagef(echo.ErrNotFound, "output not found: %s", outputID.ToHex())
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", outputID.ToHex(), err)
 		}
 
-		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading output failed: %s, error: %s", outputID.ToHex(), err)
+		response, err = s.newSpentResponse(spent, ledgerIndex)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if atIndex != nil {
+		existed, unspent := s.outputStateAt(output, spent, *atIndex)
+		response.StateAt = &outputStateResponse{
+			MilestoneIndex: *atIndex,
+			Existed:        existed,
+			Unspent:        unspent,
+		}
 	}
 
-	return newSpentResponse(spent, ledgerIndex)
+	return response, nil
 }
 
 //nolint:interfacer // false positive
 func (s *DatabaseServer) ed25519Balance(address *iotago.Ed25519Address) (*addressBalanceResponse, error) {
-	balance, dustAllowed, ledgerIndex, err := s.UTXOManager.AddressBalance(address)
+	balance, ledgerIndex, err := s.UTXOManager.AddressBalanceDetails(address)
 	if err != nil {
 		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading address balance failed: %s, error: %s", address, err)
 	}
 
+	maxResults := s.RestAPILimitsMaxResults
+
+	dustAllowanceOutputs, err := s.UTXOManager.UnspentOutputs(
+		utxo.FilterAddress(address),
+		utxo.FilterOutputType(iotago.OutputSigLockedDustAllowanceOutput),
+		utxo.MaxResultCount(maxResults),
+	)
+	if err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading dust allowance outputs failed: %s, error: %s", address, err)
+	}
+
+	dustAllowanceOutputIDs := make([]string, len(dustAllowanceOutputs))
+	for i, output := range dustAllowanceOutputs {
+		dustAllowanceOutputIDs[i] = output.OutputID().ToHex()
+	}
+
 	return &addressBalanceResponse{
-		AddressType: address.Type(),
-		Address:     address.String(),
-		Balance:     balance,
-		DustAllowed: dustAllowed,
-		LedgerIndex: ledgerIndex,
+		AddressType:                    address.Type(),
+		Address:                        address.String(),
+		Balance:                        balance.Balance,
+		DustAllowed:                    balance.DustAllowed,
+		DustAllowanceBalance:           balance.DustAllowanceBalance,
+		DustOutputCount:                balance.DustOutputCount,
+		RemainingDustOutputs:           balance.RemainingDustOutputs,
+		DustAllowanceOutputsMaxResults: uint32(maxResults),
+		DustAllowanceOutputsCount:      uint32(len(dustAllowanceOutputIDs)),
+		DustAllowanceOutputIDs:         dustAllowanceOutputIDs,
+		LedgerIndex:                    ledgerIndex,
 	}, nil
 }
 
@@ -190,6 +296,54 @@ func (s *DatabaseServer) outputsResponse(address iotago.Address, includeSpent bo
 	}, nil
 }
 
+// outputsAtResponse returns the outputs that were unspent on the address at the given milestone index.
+// These are the current unspent outputs and the outputs spent after that milestone index,
+// without the outputs that were created after it.
+func (s *DatabaseServer) outputsAtResponse(address iotago.Address, atIndex milestone.Index, filterType *iotago.OutputType) (*addressOutputsResponse, error) {
+	maxResults := s.RestAPILimitsMaxResults
+
+	opts := []utxo.IterateOption{
+		utxo.FilterAddress(address),
+	}
+
+	if filterType != nil {
+		opts = append(opts, utxo.FilterOutputType(*filterType))
+	}
+
+	outputIDs := make([]string, 0)
+
+	if err := s.UTXOManager.ForEachUnspentOutput(func(output *utxo.Output) bool {
+		if existed, _ := s.outputStateAt(output, nil, atIndex); existed {
+			outputIDs = append(outputIDs, output.OutputID().ToHex())
+		}
+
+		return len(outputIDs) < maxResults
+	}, opts...); err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading unspent outputs failed: %s, error: %s", address, err)
+	}
+
+	if len(outputIDs) < maxResults {
+		if err := s.UTXOManager.ForEachSpentOutput(func(spent *utxo.Spent) bool {
+			if _, unspent := s.outputStateAt(spent.Output(), spent, atIndex); unspent {
+				outputIDs = append(outputIDs, spent.OutputID().ToHex())
+			}
+
+			return len(outputIDs) < maxResults
+		}, opts...); err != nil {
+			return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading spent outputs failed: %s, error: %s", address, err)
+		}
+	}
+
+	return &addressOutputsResponse{
+		AddressType: address.Type(),
+		Address:     address.String(),
+		MaxResults:  uint32(maxResults),
+		Count:       uint32(len(outputIDs)),
+		OutputIDs:   outputIDs,
+		LedgerIndex: atIndex,
+	}, nil
+}
+
 func (s *DatabaseServer) outputsIDsByBech32Address(c echo.Context) (*addressOutputsResponse, error) {
 	// error is ignored because it returns false in case it can't be parsed
 	includeSpent, _ := strconv.ParseBool(strings.ToLower(c.QueryParam("include-spent")))
@@ -214,6 +368,19 @@ func (s *DatabaseServer) outputsIDsByBech32Address(c echo.Context) (*addressOutp
 		return nil, err
 	}
 
+	atIndex, err := s.parseLedgerIndexAtQueryParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if atIndex != nil {
+		if includeSpent {
+			return nil, errors.WithMessage(restapi.ErrInvalidParameter, "query parameters \"include-spent\" and \"at\" can't be combined")
+		}
+
+		return s.outputsAtResponse(bech32Address, *atIndex, filteredType)
+	}
+
 	return s.outputsResponse(bech32Address, includeSpent, filteredType)
 }
 
@@ -240,9 +407,95 @@ func (s *DatabaseServer) outputsIDsByEd25519Address(c echo.Context) (*addressOut
 		return nil, err
 	}
 
+	atIndex, err := s.parseLedgerIndexAtQueryParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if atIndex != nil {
+		if includeSpent {
+			return nil, errors.WithMessage(restapi.ErrInvalidParameter, "query parameters \"include-spent\" and \"at\" can't be combined")
+		}
+
+		return s.outputsAtResponse(address, *atIndex, filteredType)
+	}
+
 	return s.outputsResponse(address, includeSpent, filteredType)
 }
 
+func (s *DatabaseServer) addressSummary(address iotago.Address) (*addressSummaryResponse, error) {
+	ledgerIndex := s.UTXOManager.ReadLedgerIndex()
+	snapshotIndex := s.Database.SnapshotInfo().SnapshotIndex
+
+	response := &addressSummaryResponse{
+		AddressType:   address.Type(),
+		Address:       address.String(),
+		SnapshotIndex: snapshotIndex,
+		LedgerIndex:   ledgerIndex,
+	}
+
+	addReceived := func(output *utxo.Output) {
+		response.OutputCount++
+		response.TotalReceived += output.Amount()
+
+		// outputs without a creating milestone after the snapshot index were part of the snapshot the ledger was started from,
+		// the milestone at which they were received is unknown.
+		milestoneIndexBooked, booked := s.outputMilestoneIndexBooked(output)
+		if !booked || milestoneIndexBooked <= snapshotIndex {
+			response.SnapshotOutputCount++
+
+			return
+		}
+
+		if response.FirstReceivedMilestoneIndex == nil || milestoneIndexBooked < *response.FirstReceivedMilestoneIndex {
+			response.FirstReceivedMilestoneIndex = &milestoneIndexBooked
+		}
+	}
+
+	if err := s.UTXOManager.ForEachUnspentOutput(func(output *utxo.Output) bool {
+		addReceived(output)
+
+		return true
+	}, utxo.FilterAddress(address)); err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading unspent outputs failed: %s, error: %s", address, err)
+	}
+
+	if err := s.UTXOManager.ForEachSpentOutput(func(spent *utxo.Spent) bool {
+		addReceived(spent.Output())
+
+		response.SpentCount++
+		response.TotalSent += spent.Amount()
+
+		if confirmationIndex := spent.ConfirmationIndex(); response.LastSpentMilestoneIndex == nil || confirmationIndex > *response.LastSpentMilestoneIndex {
+			response.LastSpentMilestoneIndex = &confirmationIndex
+		}
+
+		return true
+	}, utxo.FilterAddress(address)); err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "reading spent outputs failed: %s, error: %s", address, err)
+	}
+
+	return response, nil
+}
+
+func (s *DatabaseServer) summaryByBech32Address(c echo.Context) (*addressSummaryResponse, error) {
+	bech32Address, err := restapi.ParseBech32AddressParam(c, s.Bech32HRP)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.addressSummary(bech32Address)
+}
+
+func (s *DatabaseServer) summaryByEd25519Address(c echo.Context) (*addressSummaryResponse, error) {
+	address, err := restapi.ParseEd25519AddressParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.addressSummary(address)
+}
+
 func (s *DatabaseServer) treasury(_ echo.Context) (*treasuryResponse, error) {
 
 	treasuryOutput, err := s.UTXOManager.UnspentTreasuryOutput()
@@ -255,3 +508,66 @@ func (s *DatabaseServer) treasury(_ echo.Context) (*treasuryResponse, error) {
 		Amount:      treasuryOutput.Amount,
 	}, nil
 }
+
+func (s *DatabaseServer) ledgerCommitment() (*ledgerCommitmentResponse, error) {
+
+	ledgerCommitment, err := s.UTXOManager.LedgerCommitment()
+	if err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "computing ledger state commitment failed, error: %s", err)
+	}
+
+	return &ledgerCommitmentResponse{
+		LedgerIndex:    ledgerCommitment.LedgerIndex,
+		Commitment:     hex.EncodeToString(ledgerCommitment.Root()),
+		Addresses:      uint32(ledgerCommitment.Addresses()),
+		UnspentOutputs: uint32(ledgerCommitment.UnspentOutputs),
+		TreasuryOutput: &treasuryResponse{
+			MilestoneID: hex.EncodeToString(ledgerCommitment.TreasuryOutput.MilestoneID[:]),
+			Amount:      ledgerCommitment.TreasuryOutput.Amount,
+		},
+	}, nil
+}
+
+func (s *DatabaseServer) balanceProof(address iotago.Address) (*addressBalanceProofResponse, error) {
+
+	ledgerCommitment, err := s.UTXOManager.LedgerCommitment()
+	if err != nil {
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "computing ledger state commitment failed, error: %s", err)
+	}
+
+	proof, err := ledgerCommitment.BalanceProof(address)
+	if err != nil {
+		if errors.Is(err, utxo.ErrAddressNotInLedgerCommitment) {
+			return nil, errors.WithMessagef(echo.ErrNotFound, "address has no unspent outputs: %s", address.String())
+		}
+
+		return nil, errors.WithMessagef(echo.ErrInternalServerError, "creating balance proof failed, error: %s", err)
+	}
+
+	return &addressBalanceProofResponse{
+		AddressType: address.Type(),
+		Address:     address.String(),
+		Balance:     proof.Balance(),
+		LedgerIndex: ledgerCommitment.LedgerIndex,
+		Commitment:  hex.EncodeToString(ledgerCommitment.Root()),
+		Proof:       proof,
+	}, nil
+}
+
+func (s *DatabaseServer) balanceProofByBech32Address(c echo.Context) (*addressBalanceProofResponse, error) {
+	bech32Address, err := restapi.ParseBech32AddressParam(c, s.Bech32HRP)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.balanceProof(bech32Address)
+}
+
+func (s *DatabaseServer) balanceProofByEd25519Address(c echo.Context) (*addressBalanceProofResponse, error) {
+	address, err := restapi.ParseEd25519AddressParam(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.balanceProof(address)
+}
@@ -0,0 +1,160 @@
+package toolset
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	flag "github.com/spf13/pflag"
+
+	hivedb "github.com/iotaledger/hive.go/core/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/database"
+	"github.com/iotaledger/inx-api-core-v1/pkg/database/engine"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+const (
+	// ToolsCommand is the command line argument that invokes the toolset instead of the app.
+	ToolsCommand = "tools"
+
+	FlagToolTangleDatabasePath = "tangleDatabasePath"
+	FlagToolUTXODatabasePath   = "utxoDatabasePath"
+	FlagToolNetworkID          = "networkID"
+	FlagToolStartIndex         = "startIndex"
+	FlagToolEndIndex           = "endIndex"
+)
+
+const (
+	ToolVerifyMilestones  = "verify-milestones"
+	ToolVerifyLedger      = "verify-ledger"
+	ToolVerifyConsistency = "verify-consistency"
+	ToolVerifySignatures  = "verify-signatures"
+)
+
+const (
+	DefaultValueTangleDatabasePath = "database/tangle"
+	DefaultValueUTXODatabasePath   = "database/utxo"
+	DefaultValueNetworkID          = "chrysalis-mainnet"
+)
+
+type tool func(args []string) error
+
+func tools() map[string]tool {
+	return map[string]tool{
+		ToolVerifyMilestones:  verifyMilestones,
+		ToolVerifyLedger:      verifyLedger,
+		ToolVerifyConsistency: verifyConsistency,
+		ToolVerifySignatures:  verifySignatures,
+	}
+}
+
+// ShouldHandleTools checks if the toolset was invoked via the command line.
+func ShouldHandleTools() bool {
+	return len(os.Args) > 1 && strings.EqualFold(os.Args[1], ToolsCommand)
+}
+
+// HandleTools runs the tool given on the command line and exits the process.
+// The process exits with a non-zero exit code if the tool failed.
+func HandleTools() {
+	if len(os.Args) < 3 {
+		listTools()
+		os.Exit(1)
+	}
+
+	toolFunc, exists := tools()[strings.ToLower(os.Args[2])]
+	if !exists {
+		fmt.Printf("tool \"%s\" not found\n\n", os.Args[2])
+		listTools()
+		os.Exit(1)
+	}
+
+	if err := toolFunc(os.Args[3:]); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Printf("\nerror: %s\n", err)
+		}
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func listTools() {
+	toolNames := make([]string, 0)
+	for toolName := range tools() {
+		toolNames = append(toolNames, toolName)
+	}
+	sort.Strings(toolNames)
+
+	fmt.Printf("usage: %s %s <tool> [flags]\n\navailable tools:\n", os.Args[0], ToolsCommand)
+	for _, toolName := range toolNames {
+		fmt.Printf("  %s\n", toolName)
+	}
+}
+
+// databaseFlags registers the flags that are needed to open the databases.
+func databaseFlags(fs *flag.FlagSet) (tangleDatabasePath *string, utxoDatabasePath *string, networkID *string) {
+	tangleDatabasePath = fs.String(FlagToolTangleDatabasePath, DefaultValueTangleDatabasePath, "the path to the tangle database folder")
+	utxoDatabasePath = fs.String(FlagToolUTXODatabasePath, DefaultValueUTXODatabasePath, "the path to the UTXO database folder")
+	networkID = fs.String(FlagToolNetworkID, DefaultValueNetworkID, "the network ID of the databases")
+
+	return tangleDatabasePath, utxoDatabasePath, networkID
+}
+
+// milestoneRangeFlags registers the flags that define a range of milestones.
+func milestoneRangeFlags(fs *flag.FlagSet) (startIndex *uint32, endIndex *uint32) {
+	startIndex = fs.Uint32(FlagToolStartIndex, 0, "the first milestone index of the range (0 = snapshot index + 1)")
+	endIndex = fs.Uint32(FlagToolEndIndex, 0, "the last milestone index of the range (0 = ledger index)")
+
+	return startIndex, endIndex
+}
+
+// milestoneRange returns the milestone range that is available in the database and was given via the flags.
+func milestoneRange(db *database.Database, startIndex uint32, endIndex uint32) (milestone.Index, milestone.Index, error) {
+	snapshotIndex := db.SnapshotInfo().SnapshotIndex
+	ledgerIndex := db.UTXOManager().ReadLedgerIndex()
+
+	start := snapshotIndex + 1
+	if startIndex != 0 {
+		start = milestone.Index(startIndex)
+	}
+
+	end := ledgerIndex
+	if endIndex != 0 {
+		end = milestone.Index(endIndex)
+	}
+
+	if start <= snapshotIndex || end > ledgerIndex || start > end {
+		return 0, 0, fmt.Errorf("invalid milestone range %d-%d, available range: %d-%d", start, end, snapshotIndex+1, ledgerIndex)
+	}
+
+	return start, end, nil
+}
+
+// openDatabase opens the tangle and UTXO databases at the given paths in read-only mode.
+// The tools don't modify the databases, so they can run alongside an instance serving the same databases.
+func openDatabase(tangleDatabasePath string, utxoDatabasePath string, networkID string) (*database.Database, error) {
+	tangleDatabase, err := engine.StoreWithDefaultSettings(tangleDatabasePath, false, true, hivedb.EngineAuto, engine.AllowedEnginesStorageAuto...)
+	if err != nil {
+		return nil, fmt.Errorf("opening tangle database failed: %w", err)
+	}
+
+	utxoDatabase, err := engine.StoreWithDefaultSettings(utxoDatabasePath, false, true, hivedb.EngineAuto, engine.AllowedEnginesStorageAuto...)
+	if err != nil {
+		return nil, fmt.Errorf("opening UTXO database failed: %w", err)
+	}
+
+	return database.New(tangleDatabase, utxoDatabase, iotago.NetworkIDFromString(networkID), false, true)
+}
+
+// parseFlags parses the arguments of a tool and prints the usage on error.
+func parseFlags(fs *flag.FlagSet, toolName string, args []string) error {
+	fs.Usage = func() {
+		fmt.Printf("usage of %s %s %s:\n", os.Args[0], ToolsCommand, toolName)
+		fs.PrintDefaults()
+	}
+
+	return fs.Parse(args)
+}
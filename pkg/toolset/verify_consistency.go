@@ -0,0 +1,42 @@
+package toolset
+
+import (
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+// verifyConsistency cross-checks the outputs and milestone diffs of the UTXO database with the tangle database.
+func verifyConsistency(args []string) error {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	tangleDatabasePath, utxoDatabasePath, networkID := databaseFlags(fs)
+
+	if err := parseFlags(fs, ToolVerifyConsistency, args); err != nil {
+		return err
+	}
+
+	db, err := openDatabase(*tangleDatabasePath, *utxoDatabasePath, *networkID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.CloseDatabases() }()
+
+	fmt.Println("checking consistency of the tangle and UTXO databases ...")
+
+	result, err := db.CheckConsistency(func(mismatch string) {
+		fmt.Println(mismatch)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("checked outputs: %d, checked milestone diffs: %d\n", result.Outputs, result.MilestoneDiffs)
+
+	if result.Mismatches > 0 {
+		return fmt.Errorf("%d mismatches between the tangle and the UTXO database", result.Mismatches)
+	}
+
+	fmt.Println("databases are consistent")
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package toolset
+
+import (
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+// verifyLedger replays all milestone diffs from the snapshot index to the ledger index
+// and compares the resulting ledger state with the stored unspent outputs and balances.
+func verifyLedger(args []string) error {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	tangleDatabasePath, utxoDatabasePath, networkID := databaseFlags(fs)
+
+	if err := parseFlags(fs, ToolVerifyLedger, args); err != nil {
+		return err
+	}
+
+	db, err := openDatabase(*tangleDatabasePath, *utxoDatabasePath, *networkID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.CloseDatabases() }()
+
+	snapshotIndex := db.SnapshotInfo().SnapshotIndex
+	fmt.Printf("replaying milestone diffs %d-%d ...\n", snapshotIndex+1, db.UTXOManager().ReadLedgerIndex())
+
+	result, err := db.UTXOManager().VerifyLedger(snapshotIndex, func(mismatch string) {
+		fmt.Println(mismatch)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("unspent outputs at snapshot index %d: %d, unspent outputs at ledger index %d: %d, addresses: %d\n",
+		result.SnapshotIndex, result.SnapshotUnspentOutputs, result.LedgerIndex, result.UnspentOutputs, result.Addresses)
+
+	if result.Mismatches > 0 {
+		return fmt.Errorf("%d mismatches between the replayed and the stored ledger state", result.Mismatches)
+	}
+
+	fmt.Println("ledger state verified successfully")
+
+	return nil
+}
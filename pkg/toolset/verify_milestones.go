@@ -0,0 +1,59 @@
+package toolset
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+// verifyMilestones recomputes the inclusion merkle proofs of all milestones in the given range
+// and compares them with the inclusion merkle proofs of the milestone payloads.
+func verifyMilestones(args []string) error {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	tangleDatabasePath, utxoDatabasePath, networkID := databaseFlags(fs)
+	startIndex, endIndex := milestoneRangeFlags(fs)
+
+	if err := parseFlags(fs, ToolVerifyMilestones, args); err != nil {
+		return err
+	}
+
+	db, err := openDatabase(*tangleDatabasePath, *utxoDatabasePath, *networkID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.CloseDatabases() }()
+
+	start, end, err := milestoneRange(db, *startIndex, *endIndex)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("verifying inclusion merkle proofs of milestones %d-%d ...\n", start, end)
+
+	invalidCount := 0
+	for msIndex := start; msIndex <= end; msIndex++ {
+		verification, err := db.VerifyMilestoneInclusionMerkleProof(msIndex)
+		if err != nil {
+			return fmt.Errorf("verifying milestone %d failed: %w", msIndex, err)
+		}
+
+		if !verification.Valid() {
+			invalidCount++
+			fmt.Printf("milestone %d: inclusion merkle proof mismatch, expected: %s, computed: %s (%d included messages)\n",
+				msIndex,
+				hex.EncodeToString(verification.InclusionMerkleProof),
+				hex.EncodeToString(verification.ComputedMerkleProof),
+				len(verification.IncludedMessageIDs),
+			)
+		}
+	}
+
+	if invalidCount > 0 {
+		return fmt.Errorf("%d of %d milestones have an invalid inclusion merkle proof", invalidCount, end-start+1)
+	}
+
+	fmt.Printf("verified inclusion merkle proofs of %d milestones successfully\n", end-start+1)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package toolset
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	flag "github.com/spf13/pflag"
+)
+
+// verifySignatures re-verifies the signature unlock blocks of all transactions
+// that were included in the ledger by the milestones in the given range.
+func verifySignatures(args []string) error {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	tangleDatabasePath, utxoDatabasePath, networkID := databaseFlags(fs)
+	startIndex, endIndex := milestoneRangeFlags(fs)
+
+	if err := parseFlags(fs, ToolVerifySignatures, args); err != nil {
+		return err
+	}
+
+	db, err := openDatabase(*tangleDatabasePath, *utxoDatabasePath, *networkID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.CloseDatabases() }()
+
+	start, end, err := milestoneRange(db, *startIndex, *endIndex)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("verifying transaction signatures of milestones %d-%d ...\n", start, end)
+
+	result, err := db.AuditTransactionSignatures(start, end)
+	if err != nil {
+		return err
+	}
+
+	for _, invalidTransaction := range result.InvalidTransactions {
+		fmt.Printf("milestone %d: transaction %s (message %s) has invalid signatures:\n", invalidTransaction.MilestoneIndex, hex.EncodeToString(invalidTransaction.TransactionID[:]), invalidTransaction.MessageID.ToHex())
+		for _, signatureError := range invalidTransaction.Errors {
+			fmt.Printf("\t%s\n", signatureError)
+		}
+	}
+
+	fmt.Printf("verified transactions: %d, invalid: %d\n", result.Transactions, len(result.InvalidTransactions))
+
+	if len(result.InvalidTransactions) > 0 {
+		return fmt.Errorf("%d transactions with invalid signatures", len(result.InvalidTransactions))
+	}
+
+	fmt.Println("all transaction signatures are valid")
+
+	return nil
+}
@@ -54,18 +54,116 @@ func (u *Manager) AddressBalanceWithoutLocking(address iotago.Address) (balance
 		return 0, false, err
 	}
 
-	// There is no built-in min function for int64, so inline one here
-	min := func(x, y int64) int64 {
-		if x > y {
-			return y
-		}
+	dustAllowed = maxDustOutputsForAllowance(dustAllowance) > dustOutputCount
+
+	return b, dustAllowed, nil
+}
+
+// AddressBalanceDetails contains the balance and the dust allowance details of an address.
+type AddressBalanceDetails struct {
+	// The balance of the address.
+	Balance uint64
+	// Whether dust outputs can be created on the address.
+	DustAllowed bool
+	// The sum of all dust allowance outputs on the address.
+	DustAllowanceBalance uint64
+	// The current amount of dust outputs on the address.
+	DustOutputCount int64
+	// The amount of dust outputs that can still be created on the address.
+	RemainingDustOutputs int64
+}
+
+// AddressBalanceDetails returns the balance and the dust allowance details of the given address.
+func (u *Manager) AddressBalanceDetails(address iotago.Address) (details *AddressBalanceDetails, ledgerIndex milestone.Index, err error) {
+
+	ledgerIndex = u.ReadLedgerIndex()
+
+	addressKey, err := address.Serialize(serializer.DeSeriModeNoValidation)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		return x
+	balance, dustAllowanceBalance, dustOutputCount, err := u.readBalanceForAddress(addressKey)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	dustAllowed = min(int64(dustAllowance)/iotago.DustAllowanceDivisor, iotago.MaxDustOutputsOnAddress) > dustOutputCount
+	return &AddressBalanceDetails{
+		Balance:              balance,
+		DustAllowed:          maxDustOutputsForAllowance(dustAllowanceBalance) > dustOutputCount,
+		DustAllowanceBalance: dustAllowanceBalance,
+		DustOutputCount:      dustOutputCount,
+		RemainingDustOutputs: remainingDustOutputs(dustAllowanceBalance, dustOutputCount),
+	}, ledgerIndex, nil
+}
+
+// AddressDustAllowance returns the dust allowance balance, the current amount of dust outputs
+// and the amount of dust outputs that can still be created on the given address.
+func (u *Manager) AddressDustAllowance(address iotago.Address) (dustAllowanceBalance uint64, dustOutputCount int64, remainingDustOutputCount int64, err error) {
 
-	return b, dustAllowed, nil
+	addressKey, err := address.Serialize(serializer.DeSeriModeNoValidation)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	_, dustAllowanceBalance, dustOutputCount, err = u.readBalanceForAddress(addressKey)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return dustAllowanceBalance, dustOutputCount, remainingDustOutputs(dustAllowanceBalance, dustOutputCount), nil
+}
+
+// remainingDustOutputs returns the amount of dust outputs that can still be created
+// for the given dust allowance balance and the current amount of dust outputs.
+func remainingDustOutputs(dustAllowanceBalance uint64, dustOutputCount int64) int64 {
+	remaining := maxDustOutputsForAllowance(dustAllowanceBalance) - dustOutputCount
+	if remaining < 0 {
+		return 0
+	}
+
+	return remaining
+}
+
+// maxDustOutputsForAllowance returns the maximum amount of dust outputs allowed for the given dust allowance balance.
+func maxDustOutputsForAllowance(dustAllowanceBalance uint64) int64 {
+	maxDustOutputs := int64(dustAllowanceBalance) / iotago.DustAllowanceDivisor
+	if maxDustOutputs > iotago.MaxDustOutputsOnAddress {
+		return iotago.MaxDustOutputsOnAddress
+	}
+
+	return maxDustOutputs
+}
+
+// BalanceConsumer is a function that consumes the stored balance entry of an address.
+type BalanceConsumer func(address iotago.Address, balance uint64, dustAllowanceBalance uint64, dustOutputCount int64) bool
+
+// ForEachBalance calls the consumer for every stored balance entry.
+func (u *Manager) ForEachBalance(consumer BalanceConsumer) error {
+
+	var innerErr error
+
+	if err := u.utxoStorage.Iterate([]byte{UTXOStoreKeyPrefixBalances}, func(key kvstore.Key, value kvstore.Value) bool {
+		address, err := parseAddress(marshalutil.New(key[1:]))
+		if err != nil {
+			innerErr = err
+
+			return false
+		}
+
+		balance, dustAllowanceBalance, dustOutputCount, err := balanceFromBytes(value)
+		if err != nil {
+			innerErr = err
+
+			return false
+		}
+
+		return consumer(address, balance, dustAllowanceBalance, dustOutputCount)
+	}); err != nil {
+		return err
+	}
+
+	return innerErr
 }
 
 func (u *Manager) readBalanceForAddress(addressKey []byte) (balance uint64, dustAllowanceBalance uint64, dustOutputCount int64, err error) {
@@ -0,0 +1,147 @@
+package utxo
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/serializer"
+	"github.com/iotaledger/inx-api-core-v1/pkg/commitment"
+	"github.com/iotaledger/inx-api-core-v1/pkg/merkle"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+var (
+	// ErrAddressNotInLedgerCommitment is returned if an address has no unspent outputs in the committed ledger state.
+	ErrAddressNotInLedgerCommitment = errors.New("address has no unspent outputs in the ledger state commitment")
+)
+
+// LedgerCommitment is a deterministic commitment to the ledger state at the ledger index.
+//
+// The commitment is the merkle tree hash (see merkle.Hasher) over one leaf per address with unspent outputs,
+// sorted by the serialized address, followed by the leaf of the unspent treasury output (see commitment.AddressLeaf
+// and commitment.TreasuryLeaf).
+type LedgerCommitment struct {
+	// The ledger index of the committed ledger state.
+	LedgerIndex milestone.Index
+	// The amount of unspent outputs.
+	UnspentOutputs int
+	// The unspent treasury output.
+	TreasuryOutput *TreasuryOutput
+
+	// the addresses of the leaves, the leaf after the last address is the treasury output.
+	addresses []iotago.Address
+	// the committed outputs of the addresses.
+	outputs [][]*commitment.Output
+	// the leaf index of every serialized address.
+	addressIndex map[string]int
+	tree         *merkle.Tree
+}
+
+// Root returns the ledger state commitment.
+func (c *LedgerCommitment) Root() []byte {
+	return c.tree.Root()
+}
+
+// Addresses returns the amount of addresses with unspent outputs.
+func (c *LedgerCommitment) Addresses() int {
+	return len(c.addresses)
+}
+
+// BalanceProof returns the proof of the unspent outputs of the given address against the ledger state commitment.
+func (c *LedgerCommitment) BalanceProof(address iotago.Address) (*commitment.BalanceProof, error) {
+	addressBytes, err := address.Serialize(serializer.DeSeriModeNoValidation)
+	if err != nil {
+		return nil, err
+	}
+
+	index, exists := c.addressIndex[string(addressBytes)]
+	if !exists {
+		return nil, ErrAddressNotInLedgerCommitment
+	}
+
+	auditPath, err := c.tree.AuditPath(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return &commitment.BalanceProof{
+		Address:   c.addresses[index],
+		Outputs:   c.outputs[index],
+		AuditPath: auditPath,
+	}, nil
+}
+
+// LedgerCommitment returns the commitment to the current ledger state.
+// The commitment is computed on the first call and cached afterwards.
+func (u *Manager) LedgerCommitment() (*LedgerCommitment, error) {
+	u.ledgerCommitmentLock.Lock()
+	defer u.ledgerCommitmentLock.Unlock()
+
+	if u.ledgerCommitment != nil {
+		return u.ledgerCommitment, nil
+	}
+
+	ledgerCommitment, err := u.computeLedgerCommitment()
+	if err != nil {
+		return nil, err
+	}
+	u.ledgerCommitment = ledgerCommitment
+
+	return ledgerCommitment, nil
+}
+
+func (u *Manager) computeLedgerCommitment() (*LedgerCommitment, error) {
+	ledgerCommitment := &LedgerCommitment{
+		LedgerIndex:  u.ReadLedgerIndex(),
+		addresses:    make([]iotago.Address, 0),
+		outputs:      make([][]*commitment.Output, 0),
+		addressIndex: make(map[string]int),
+	}
+
+	// the unspent outputs are iterated in key order, which is sorted by address, output type and output ID.
+	var lastAddressBytes []byte
+	if err := u.ForEachUnspentOutput(func(output *Output) bool {
+		addressBytes := output.AddressBytes()
+		if lastAddressBytes == nil || !bytes.Equal(addressBytes, lastAddressBytes) {
+			ledgerCommitment.addressIndex[string(addressBytes)] = len(ledgerCommitment.addresses)
+			ledgerCommitment.addresses = append(ledgerCommitment.addresses, output.Address())
+			ledgerCommitment.outputs = append(ledgerCommitment.outputs, make([]*commitment.Output, 0))
+			lastAddressBytes = addressBytes
+		}
+
+		last := len(ledgerCommitment.outputs) - 1
+		ledgerCommitment.outputs[last] = append(ledgerCommitment.outputs[last], &commitment.Output{
+			OutputID:   *output.OutputID(),
+			OutputType: output.OutputType(),
+			Amount:     output.Amount(),
+		})
+		ledgerCommitment.UnspentOutputs++
+
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("iterating unspent outputs failed: %w", err)
+	}
+
+	treasuryOutput, err := u.UnspentTreasuryOutput()
+	if err != nil {
+		return nil, fmt.Errorf("loading unspent treasury output failed: %w", err)
+	}
+	ledgerCommitment.TreasuryOutput = treasuryOutput
+
+	leaves := make([][]byte, 0, len(ledgerCommitment.addresses)+1)
+	for i, address := range ledgerCommitment.addresses {
+		leaf, err := commitment.AddressLeaf(address, ledgerCommitment.outputs[i])
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, leaf)
+	}
+	leaves = append(leaves, commitment.TreasuryLeaf(treasuryOutput.MilestoneID, treasuryOutput.Amount))
+
+	ledgerCommitment.tree = merkle.NewHasher().NewTree(leaves)
+
+	return ledgerCommitment, nil
+}
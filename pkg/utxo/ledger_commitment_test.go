@@ -0,0 +1,141 @@
+package utxo
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/core/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/serializer"
+	"github.com/iotaledger/inx-api-core-v1/pkg/commitment"
+	"github.com/iotaledger/inx-api-core-v1/pkg/merkle"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+func testAddress(fill byte) *iotago.Ed25519Address {
+	address := &iotago.Ed25519Address{}
+	for i := range address {
+		address[i] = fill
+	}
+
+	return address
+}
+
+func testOutputID(fill byte, index uint16) iotago.UTXOInputID {
+	var outputID iotago.UTXOInputID
+	for i := 0; i < iotago.TransactionIDLength; i++ {
+		outputID[i] = fill
+	}
+	binary.LittleEndian.PutUint16(outputID[iotago.TransactionIDLength:], index)
+
+	return outputID
+}
+
+// storeTestUnspentOutput writes an unspent output with the storage layout of the ledger.
+func storeTestUnspentOutput(t *testing.T, store kvstore.KVStore, address *iotago.Ed25519Address, output *commitment.Output) {
+	t.Helper()
+
+	addressBytes, err := address.Serialize(serializer.DeSeriModeNoValidation)
+	require.NoError(t, err)
+
+	outputKey := append([]byte{UTXOStoreKeyPrefixOutput}, output.OutputID[:]...)
+
+	outputValue := make([]byte, 0, iotago.MessageIDLength+1+len(addressBytes)+8)
+	outputValue = append(outputValue, make([]byte, iotago.MessageIDLength)...)
+	outputValue = append(outputValue, output.OutputType)
+	outputValue = append(outputValue, addressBytes...)
+	outputValue = binary.LittleEndian.AppendUint64(outputValue, output.Amount)
+	require.NoError(t, store.Set(outputKey, outputValue))
+
+	unspentKey := append([]byte{UTXOStoreKeyPrefixUnspent}, addressBytes...)
+	unspentKey = append(unspentKey, output.OutputType)
+	unspentKey = append(unspentKey, output.OutputID[:]...)
+	require.NoError(t, store.Set(unspentKey, []byte{}))
+}
+
+func TestLedgerCommitment(t *testing.T) {
+	store := mapdb.NewMapDB()
+	require.NoError(t, store.Set([]byte{UTXOStoreKeyPrefixLedgerMilestoneIndex}, binary.LittleEndian.AppendUint32(nil, 1234)))
+
+	// the addresses are stored out of order, the leaves must be sorted by the serialized address.
+	addressHigh := testAddress(0xee)
+	addressLow := testAddress(0x11)
+
+	outputsHigh := []*commitment.Output{
+		{OutputID: testOutputID(0x03, 0), OutputType: iotago.OutputSigLockedSingleOutput, Amount: 5_000_000},
+	}
+	// the outputs of an address are sorted by output type and output ID.
+	outputsLow := []*commitment.Output{
+		{OutputID: testOutputID(0x02, 0), OutputType: iotago.OutputSigLockedSingleOutput, Amount: 2_000_000},
+		{OutputID: testOutputID(0x02, 1), OutputType: iotago.OutputSigLockedSingleOutput, Amount: 3_000_000},
+		{OutputID: testOutputID(0x01, 0), OutputType: iotago.OutputSigLockedDustAllowanceOutput, Amount: 1_000_000},
+	}
+
+	for _, output := range outputsHigh {
+		storeTestUnspentOutput(t, store, addressHigh, output)
+	}
+	for i := len(outputsLow) - 1; i >= 0; i-- {
+		storeTestUnspentOutput(t, store, addressLow, outputsLow[i])
+	}
+
+	var treasuryMilestoneID iotago.MilestoneID
+	for i := range treasuryMilestoneID {
+		treasuryMilestoneID[i] = 0xcc
+	}
+	treasuryKey := append([]byte{UTXOStoreKeyPrefixTreasuryOutput, TreasuryOutputUnspentPrefix}, treasuryMilestoneID[:]...)
+	require.NoError(t, store.Set(treasuryKey, binary.LittleEndian.AppendUint64(nil, 10_000_000)))
+
+	ledgerCommitment, err := New(store).LedgerCommitment()
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1234, ledgerCommitment.LedgerIndex)
+	require.Equal(t, 4, ledgerCommitment.UnspentOutputs)
+	require.Equal(t, 2, ledgerCommitment.Addresses())
+	require.Equal(t, "6374e3cc5b8d5088382aa52ec92ca641387c6bc21a08969d19495ed3bd07892f", hex.EncodeToString(ledgerCommitment.Root()))
+
+	// the leaves are the addresses sorted by the serialized address, followed by the treasury output.
+	leafLow, err := commitment.AddressLeaf(addressLow, outputsLow)
+	require.NoError(t, err)
+	leafHigh, err := commitment.AddressLeaf(addressHigh, outputsHigh)
+	require.NoError(t, err)
+	leafTreasury := commitment.TreasuryLeaf(treasuryMilestoneID, 10_000_000)
+
+	hasher := merkle.NewHasher()
+	require.Equal(t, hasher.Hash([][]byte{leafLow, leafHigh, leafTreasury}), ledgerCommitment.Root())
+
+	treasuryAuditPath, err := ledgerCommitment.tree.AuditPath(ledgerCommitment.Addresses())
+	require.NoError(t, err)
+	require.True(t, hasher.VerifyAuditPath(ledgerCommitment.Root(), leafTreasury, treasuryAuditPath))
+
+	tests := []struct {
+		name            string
+		address         iotago.Address
+		expectedOutputs []*commitment.Output
+	}{
+		{
+			name:            "lower address",
+			address:         addressLow,
+			expectedOutputs: outputsLow,
+		},
+		{
+			name:            "higher address",
+			address:         addressHigh,
+			expectedOutputs: outputsHigh,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof, err := ledgerCommitment.BalanceProof(tt.address)
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedOutputs, proof.Outputs)
+			require.NoError(t, commitment.VerifyBalanceProof(ledgerCommitment.Root(), proof))
+		})
+	}
+
+	_, err = ledgerCommitment.BalanceProof(testAddress(0x55))
+	require.ErrorIs(t, err, ErrAddressNotInLedgerCommitment)
+}
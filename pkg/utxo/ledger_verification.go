@@ -0,0 +1,274 @@
+package utxo
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotaledger/hive.go/core/kvstore"
+	"github.com/iotaledger/hive.go/serializer"
+	"github.com/iotaledger/inx-api-core-v1/pkg/milestone"
+	iotago "github.com/iotaledger/iota.go/v2"
+)
+
+// LedgerMismatchConsumer is a function that consumes the description of a mismatch found during the ledger verification.
+type LedgerMismatchConsumer func(mismatch string)
+
+// LedgerVerificationResult is the result of a ledger replay.
+type LedgerVerificationResult struct {
+	// The index of the snapshot the replay started from.
+	SnapshotIndex milestone.Index
+	// The ledger index the replay ended at.
+	LedgerIndex milestone.Index
+	// The amount of unspent outputs at the snapshot index.
+	SnapshotUnspentOutputs int
+	// The amount of unspent outputs after the replay.
+	UnspentOutputs int
+	// The amount of addresses with a balance after the replay.
+	Addresses int
+	// The amount of mismatches between the replayed and the stored ledger state.
+	Mismatches int
+}
+
+// addressBalance is the balance entry of an address, computed from its unspent outputs.
+type addressBalance struct {
+	balance              uint64
+	dustAllowanceBalance uint64
+	dustOutputCount      int64
+}
+
+func (b *addressBalance) add(output *Output) {
+	b.balance += output.Amount()
+
+	switch output.OutputType() {
+	case iotago.OutputSigLockedDustAllowanceOutput:
+		b.dustAllowanceBalance += output.Amount()
+	case iotago.OutputSigLockedSingleOutput:
+		if output.Amount() < iotago.OutputSigLockedDustAllowanceOutputMinDeposit {
+			b.dustOutputCount++
+		}
+	}
+}
+
+// sortedKeys returns the keys of the given map in ascending order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// VerifyLedger replays all milestone diffs from the given snapshot index to the ledger index and compares the resulting
+// unspent outputs, balances and treasury output with the stored ones. Every mismatch is passed to the consumer.
+//
+// The unspent outputs at the snapshot index are derived from the stored outputs and spents,
+// so the replay does not depend on the stored unspent outputs and balances that are verified.
+func (u *Manager) VerifyLedger(snapshotIndex milestone.Index, consumer LedgerMismatchConsumer) (*LedgerVerificationResult, error) {
+	ledgerIndex := u.ReadLedgerIndex()
+
+	result := &LedgerVerificationResult{
+		SnapshotIndex: snapshotIndex,
+		LedgerIndex:   ledgerIndex,
+	}
+
+	mismatch := func(format string, args ...interface{}) {
+		result.Mismatches++
+		consumer(fmt.Sprintf(format, args...))
+	}
+
+	// collect the outputs that were created after the snapshot index
+	createdAfterSnapshot := make(map[string]struct{})
+	for msIndex := snapshotIndex + 1; msIndex <= ledgerIndex; msIndex++ {
+		diff, err := u.MilestoneDiff(msIndex)
+		if err != nil {
+			if errors.Is(err, kvstore.ErrKeyNotFound) {
+				// reported during the replay
+				continue
+			}
+
+			return nil, fmt.Errorf("loading milestone diff %d failed: %w", msIndex, err)
+		}
+
+		for _, output := range diff.Outputs {
+			createdAfterSnapshot[string(output.OutputID()[:])] = struct{}{}
+		}
+	}
+
+	spentUntilSnapshot := make(map[string]struct{})
+	if err := u.ForEachSpentOutput(func(spent *Spent) bool {
+		if spent.ConfirmationIndex() <= snapshotIndex {
+			spentUntilSnapshot[string(spent.OutputID()[:])] = struct{}{}
+		}
+
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("iterating spent outputs failed: %w", err)
+	}
+
+	// rebuild the unspent outputs at the snapshot index
+	unspent := make(map[string]*Output)
+	if err := u.ForEachOutput(func(output *Output) bool {
+		outputKey := string(output.OutputID()[:])
+		if _, created := createdAfterSnapshot[outputKey]; created {
+			return true
+		}
+		if _, spent := spentUntilSnapshot[outputKey]; spent {
+			return true
+		}
+		unspent[outputKey] = output
+
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("iterating outputs failed: %w", err)
+	}
+	result.SnapshotUnspentOutputs = len(unspent)
+
+	// replay the milestone diffs
+	var treasuryOutput *TreasuryOutput
+	for msIndex := snapshotIndex + 1; msIndex <= ledgerIndex; msIndex++ {
+		diff, err := u.MilestoneDiff(msIndex)
+		if err != nil {
+			if errors.Is(err, kvstore.ErrKeyNotFound) {
+				mismatch("milestone %d: milestone diff missing", msIndex)
+
+				continue
+			}
+
+			return nil, fmt.Errorf("loading milestone diff %d failed: %w", msIndex, err)
+		}
+
+		for _, spent := range diff.Spents {
+			outputKey := string(spent.OutputID()[:])
+			if _, exists := unspent[outputKey]; !exists {
+				mismatch("milestone %d: spent output %s was not unspent", msIndex, spent.OutputID().ToHex())
+			}
+			if spent.ConfirmationIndex() != msIndex {
+				mismatch("milestone %d: spent output %s has confirmation index %d", msIndex, spent.OutputID().ToHex(), spent.ConfirmationIndex())
+			}
+			delete(unspent, outputKey)
+		}
+
+		for _, output := range diff.Outputs {
+			outputKey := string(output.OutputID()[:])
+			if _, exists := unspent[outputKey]; exists {
+				mismatch("milestone %d: created output %s was already unspent", msIndex, output.OutputID().ToHex())
+			}
+			unspent[outputKey] = output
+		}
+
+		if diff.TreasuryOutput != nil {
+			if treasuryOutput != nil && (diff.SpentTreasuryOutput == nil || diff.SpentTreasuryOutput.MilestoneID != treasuryOutput.MilestoneID) {
+				mismatch("milestone %d: spent treasury output is not the treasury output of milestone %s", msIndex, hex.EncodeToString(treasuryOutput.MilestoneID[:]))
+			}
+			treasuryOutput = diff.TreasuryOutput
+		}
+	}
+	result.UnspentOutputs = len(unspent)
+
+	// compare the unspent outputs
+	storedUnspent := make(map[string]*Output)
+	if err := u.ForEachUnspentOutput(func(output *Output) bool {
+		storedUnspent[string(output.OutputID()[:])] = output
+
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("iterating unspent outputs failed: %w", err)
+	}
+
+	for _, outputKey := range sortedKeys(storedUnspent) {
+		if _, exists := unspent[outputKey]; !exists {
+			mismatch("stored unspent output %s is not unspent in the replayed ledger", storedUnspent[outputKey].OutputID().ToHex())
+		}
+	}
+
+	for _, outputKey := range sortedKeys(unspent) {
+		if _, exists := storedUnspent[outputKey]; !exists {
+			mismatch("unspent output %s of the replayed ledger is not stored as unspent", unspent[outputKey].OutputID().ToHex())
+		}
+	}
+
+	// compare the balances
+	balances := make(map[string]*addressBalance)
+	addresses := make(map[string]iotago.Address)
+	for _, output := range unspent {
+		addressKey := string(output.AddressBytes())
+		if _, exists := balances[addressKey]; !exists {
+			balances[addressKey] = &addressBalance{}
+			addresses[addressKey] = output.Address()
+		}
+		balances[addressKey].add(output)
+	}
+	result.Addresses = len(balances)
+
+	var innerErr error
+	storedBalances := make(map[string]*addressBalance)
+	if err := u.ForEachBalance(func(address iotago.Address, balance uint64, dustAllowanceBalance uint64, dustOutputCount int64) bool {
+		addressBytes, err := address.Serialize(serializer.DeSeriModeNoValidation)
+		if err != nil {
+			innerErr = fmt.Errorf("serializing address %s failed: %w", address.String(), err)
+
+			return false
+		}
+
+		addressKey := string(addressBytes)
+		storedBalances[addressKey] = &addressBalance{
+			balance:              balance,
+			dustAllowanceBalance: dustAllowanceBalance,
+			dustOutputCount:      dustOutputCount,
+		}
+		addresses[addressKey] = address
+
+		return true
+	}); err != nil {
+		return nil, fmt.Errorf("iterating balances failed: %w", err)
+	}
+	if innerErr != nil {
+		return nil, innerErr
+	}
+
+	addressKeys := sortedKeys(addresses)
+	for _, addressKey := range addressKeys {
+		computed, exists := balances[addressKey]
+		if !exists {
+			computed = &addressBalance{}
+		}
+
+		stored, exists := storedBalances[addressKey]
+		if !exists {
+			stored = &addressBalance{}
+		}
+
+		if *computed != *stored {
+			mismatch("address %s: stored balance %d, dust allowance %d, dust outputs %d, replayed balance %d, dust allowance %d, dust outputs %d",
+				addresses[addressKey].String(),
+				stored.balance, stored.dustAllowanceBalance, stored.dustOutputCount,
+				computed.balance, computed.dustAllowanceBalance, computed.dustOutputCount,
+			)
+		}
+	}
+
+	// compare the treasury output.
+	// there is always exactly one unspent treasury output, even if no milestone after the snapshot created a new one.
+	storedTreasuryOutput, err := u.UnspentTreasuryOutput()
+	switch {
+	case err != nil:
+		mismatch("loading stored unspent treasury output failed: %s", err)
+	case treasuryOutput == nil:
+		// the treasury output of the snapshot is still unspent, so it must not be stored as spent
+		if _, err := u.readSpentTreasuryOutput(storedTreasuryOutput.MilestoneID[:]); err == nil {
+			mismatch("stored unspent treasury output of milestone %s is also stored as spent", hex.EncodeToString(storedTreasuryOutput.MilestoneID[:]))
+		} else if !errors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, fmt.Errorf("loading spent treasury output failed: %w", err)
+		}
+	case !bytes.Equal(storedTreasuryOutput.MilestoneID[:], treasuryOutput.MilestoneID[:]) || storedTreasuryOutput.Amount != treasuryOutput.Amount:
+		mismatch("stored unspent treasury output does not match the treasury output of milestone %s with amount %d", hex.EncodeToString(treasuryOutput.MilestoneID[:]), treasuryOutput.Amount)
+	}
+
+	return result, nil
+}
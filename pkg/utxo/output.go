@@ -1,7 +1,10 @@
 package utxo
 
 import (
+	"fmt"
+
 	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/core/kvstore"
 	"github.com/iotaledger/hive.go/core/marshalutil"
 	"github.com/iotaledger/hive.go/serializer"
 	"github.com/iotaledger/inx-api-core-v1/pkg/hornet"
@@ -51,6 +54,18 @@ func (o *Output) AddressBytes() []byte {
 	return bytes
 }
 
+// TransactionOutput returns the output as it was created by the transaction.
+func (o *Output) TransactionOutput() (iotago.Output, error) {
+	switch o.outputType {
+	case iotago.OutputSigLockedSingleOutput:
+		return &iotago.SigLockedSingleOutput{Address: o.address, Amount: o.amount}, nil
+	case iotago.OutputSigLockedDustAllowanceOutput:
+		return &iotago.SigLockedDustAllowanceOutput{Address: o.address, Amount: o.amount}, nil
+	default:
+		return nil, fmt.Errorf("unknown output type: %d", o.outputType)
+	}
+}
+
 func (o *Output) kvStorableLoad(_ *Manager, key []byte, value []byte) error {
 
 	// Parse key
@@ -109,3 +124,51 @@ func (u *Manager) ReadOutputByOutputID(outputID *iotago.UTXOInputID) (*Output, e
 
 	return output, nil
 }
+
+// TransactionOutputs returns all outputs that were created by the given transaction.
+func (u *Manager) TransactionOutputs(transactionID *iotago.TransactionID) (Outputs, error) {
+	var outputs Outputs
+
+	var innerErr error
+	if err := u.utxoStorage.Iterate(byteutils.ConcatBytes([]byte{UTXOStoreKeyPrefixOutput}, transactionID[:]), func(key kvstore.Key, value kvstore.Value) bool {
+		output := &Output{}
+		if err := output.kvStorableLoad(u, key, value); err != nil {
+			innerErr = err
+
+			return false
+		}
+
+		outputs = append(outputs, output)
+
+		return true
+	}); err != nil {
+		return nil, err
+	}
+
+	if innerErr != nil {
+		return nil, innerErr
+	}
+
+	return outputs, nil
+}
+
+// ForEachOutput calls the consumer for every stored output, regardless of whether it is spent or not.
+func (u *Manager) ForEachOutput(consumer OutputConsumer) error {
+
+	var innerErr error
+
+	if err := u.utxoStorage.Iterate([]byte{UTXOStoreKeyPrefixOutput}, func(key kvstore.Key, value kvstore.Value) bool {
+		output := &Output{}
+		if err := output.kvStorableLoad(u, key, value); err != nil {
+			innerErr = err
+
+			return false
+		}
+
+		return consumer(output)
+	}); err != nil {
+		return err
+	}
+
+	return innerErr
+}
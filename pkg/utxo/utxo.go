@@ -16,6 +16,10 @@ type Manager struct {
 	// ledgerIndex
 	ledgerIndex     milestone.Index
 	ledgerIndexOnce sync.Once
+
+	// ledger state commitment
+	ledgerCommitment     *LedgerCommitment
+	ledgerCommitmentLock sync.Mutex
 }
 
 func New(store kvstore.KVStore) *Manager {
@@ -23,6 +27,9 @@ func New(store kvstore.KVStore) *Manager {
 		utxoStorage:     store,
 		ledgerIndex:     0,
 		ledgerIndexOnce: sync.Once{},
+
+		ledgerCommitment:     nil,
+		ledgerCommitmentLock: sync.Mutex{},
 	}
 }
 